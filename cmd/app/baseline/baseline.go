@@ -0,0 +1,140 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/spf13/cobra"
+
+	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/baseline"
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+const defaultBaselineFilePath = "horusec-baseline.json"
+
+type Baseline struct {
+	configs    *config.Config
+	inputPath  string
+	outputPath string
+	reason     string
+}
+
+func NewBaselineCommand(cfg *config.Config) *Baseline {
+	return &Baseline{
+		configs:    cfg,
+		outputPath: defaultBaselineFilePath,
+		reason:     baseline.DefaultReason,
+	}
+}
+
+func (b *Baseline) CreateCobraCmd() *cobra.Command {
+	baselineCmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "Manage baseline of vulnerabilities",
+		Long:  "Manage the baseline of vulnerabilities that already exists on the project",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	baselineCmd.AddCommand(b.createCreateCobraCmd())
+
+	return baselineCmd
+}
+
+func (b *Baseline) createCreateCobraCmd() *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a baseline from an analysis",
+		Long: "Create a baseline file with all vulnerabilities of an analysis JSON output. " +
+			`The baseline can be used with "horusec start --baseline" to report only new vulnerabilities`,
+		Example: `horusec start -o json -O horusec-output.json
+horusec baseline create --input horusec-output.json --output horusec-baseline.json`,
+		PersistentPreRunE: b.configs.PersistentPreRun,
+		RunE:              b.runE,
+	}
+
+	createCmd.Flags().
+		StringVarP(
+			&b.inputPath,
+			"input", "i",
+			b.inputPath,
+			"Path of the analysis JSON output used to create the baseline",
+		)
+
+	createCmd.Flags().
+		StringVarP(
+			&b.outputPath,
+			"output", "o",
+			b.outputPath,
+			"Path where the baseline file will be written",
+		)
+
+	createCmd.PersistentFlags().
+		StringP(
+			"project-path", "p",
+			b.configs.ProjectPath,
+			"Path of the analyzed project, used to read the source code around each vulnerability",
+		)
+
+	createCmd.Flags().
+		StringVar(
+			&b.reason,
+			"reason",
+			b.reason,
+			"Reason of the vulnerabilities being accepted on baseline",
+		)
+
+	_ = createCmd.MarkFlagRequired("input")
+
+	return createCmd
+}
+
+func (b *Baseline) runE(_ *cobra.Command, _ []string) error {
+	entity, err := b.readAnalysis()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadAnalysisFile+b.inputPath, err)
+		return err
+	}
+
+	fingerprinter := vulnhash.NewFingerprinter(b.configs.ProjectPath)
+	created := baseline.New(entity, b.configs.Version, b.reason, fingerprinter)
+	if err := created.Write(b.outputPath); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWriteBaselineFile+b.outputPath, err)
+		return err
+	}
+
+	logger.LogInfoWithLevel(fmt.Sprintf(messages.MsgInfoBaselineCreated, len(created.Vulnerabilities), b.outputPath))
+
+	return nil
+}
+
+func (b *Baseline) readAnalysis() (*analysis.Analysis, error) {
+	content, err := os.ReadFile(b.inputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	entity := new(analysis.Analysis)
+
+	return entity, json.Unmarshal(content, entity)
+}
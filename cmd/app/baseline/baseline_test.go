@@ -0,0 +1,84 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/baseline"
+)
+
+func TestBaseline_CreateCobraCmd(t *testing.T) {
+	t.Run("Should create baseline file from analysis JSON output", func(t *testing.T) {
+		tmp := t.TempDir()
+		inputPath := filepath.Join(tmp, "horusec-output.json")
+		outputPath := filepath.Join(tmp, "horusec-baseline.json")
+
+		content, err := json.Marshal(analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						RuleID:   "HS-LEAKS-1",
+						File:     "config.yaml",
+						Line:     "3",
+						Code:     "password: 123",
+						Type:     enumsVulnerability.Vulnerability,
+						VulnHash: "da2a4f6f0d1c1a3e",
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(inputPath, content, 0o600))
+
+		cobraCmd := NewBaselineCommand(config.New()).CreateCobraCmd()
+		createCmd, _, err := cobraCmd.Find([]string{"create"})
+		require.NoError(t, err)
+
+		// Remove the pre run hook to not configure the log file
+		createCmd.PersistentPreRunE = nil
+		cobraCmd.SetArgs([]string{"create", "--input", inputPath, "--output", outputPath, "--reason", "legacy"})
+
+		require.NoError(t, cobraCmd.Execute())
+
+		created, err := baseline.Parse(outputPath)
+		require.NoError(t, err)
+		require.Len(t, created.Vulnerabilities, 1)
+		assert.Equal(t, "da2a4f6f0d1c1a3e", created.Vulnerabilities[0].VulnHash)
+		assert.Equal(t, "HS-LEAKS-1", created.Vulnerabilities[0].RuleID)
+		assert.Equal(t, "legacy", created.Vulnerabilities[0].Reason)
+	})
+
+	t.Run("Should return error when analysis JSON output not exists", func(t *testing.T) {
+		cobraCmd := NewBaselineCommand(config.New()).CreateCobraCmd()
+		createCmd, _, err := cobraCmd.Find([]string{"create"})
+		require.NoError(t, err)
+
+		createCmd.PersistentPreRunE = nil
+		cobraCmd.SetArgs([]string{"create", "--input", filepath.Join(t.TempDir(), "not-exists.json")})
+
+		assert.Error(t, cobraCmd.Execute())
+	})
+}
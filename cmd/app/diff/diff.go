@@ -0,0 +1,182 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/spf13/cobra"
+
+	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/diff"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+// ErrNewVulnerabilities is returned when the new analysis has new vulnerabilities with
+// severity equal or higher than the fail on severity flag, or any new vulnerability when
+// the flag is not set.
+var ErrNewVulnerabilities = errors.New("diff finished with new blocking vulnerabilities")
+
+type Diff struct {
+	configs        *config.Config
+	outputFormat   string
+	outputFilePath string
+	failOnSeverity string
+	writer         io.Writer
+}
+
+func NewDiffCommand(cfg *config.Config) *Diff {
+	return &Diff{
+		configs:      cfg,
+		outputFormat: diff.OutputText,
+		writer:       os.Stdout,
+	}
+}
+
+func (d *Diff) CreateCobraCmd() *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff <old.json> <new.json>",
+		Short: "Compare the vulnerabilities of two analysis",
+		Long: "Compare the vulnerabilities of two analysis JSON outputs and classify them as new, fixed and unchanged. " +
+			"Returns exit code 1 only when there are new vulnerabilities, with severity equal or higher than --fail-on-severity if set",
+		Example: `horusec diff horusec-main.json horusec-branch.json
+horusec diff horusec-main.json horusec-branch.json -o markdown -O horusec-diff.md`,
+		Args:              cobra.ExactArgs(2), //nolint:gomnd // old and new analysis
+		PersistentPreRunE: d.configs.PersistentPreRun,
+		RunE:              d.runE,
+	}
+
+	diffCmd.Flags().
+		StringVarP(
+			&d.outputFormat,
+			"output-format", "o",
+			d.outputFormat,
+			fmt.Sprintf("Output format of diff (%q)", strings.Join(diff.Formats(), `"|"`)),
+		)
+
+	diffCmd.Flags().
+		StringVarP(
+			&d.outputFilePath,
+			"output-file", "O",
+			d.outputFilePath,
+			"Output file to write diff result. If not set, the result is written to stdout",
+		)
+
+	diffCmd.Flags().
+		StringVarP(
+			&d.failOnSeverity,
+			"fail-on-severity", "s",
+			d.failOnSeverity,
+			`Minimum severity of new vulnerabilities that returns exit code 1 ("CRITICAL"|"HIGH"|"MEDIUM"|"LOW"|"UNKNOWN"|"INFO"). If not set, any new vulnerability returns exit code 1`,
+		)
+
+	return diffCmd
+}
+
+func (d *Diff) runE(cmd *cobra.Command, args []string) error {
+	if err := d.validateFlags(); err != nil {
+		return err
+	}
+
+	oldAnalysis, err := d.readAnalysis(args[0])
+	if err != nil {
+		return err
+	}
+
+	newAnalysis, err := d.readAnalysis(args[1])
+	if err != nil {
+		return err
+	}
+
+	result := diff.Compare(oldAnalysis, newAnalysis, vulnhash.NewFingerprinter(d.configs.ProjectPath))
+	if err := d.writeResult(result); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWriteDiffOutput, err)
+		return err
+	}
+
+	if d.hasBlockingVulnerabilities(result) {
+		cmd.SetUsageFunc(func(command *cobra.Command) error {
+			return nil
+		})
+
+		return ErrNewVulnerabilities
+	}
+
+	return nil
+}
+
+// hasBlockingVulnerabilities return true if there are new vulnerabilities with severity equal
+// or higher than the fail on severity flag, or any new vulnerability when it's not set.
+func (d *Diff) hasBlockingVulnerabilities(result *diff.Result) bool {
+	if d.failOnSeverity == "" {
+		return len(result.New) > 0
+	}
+
+	return len(result.NewWithSeverity(severities.GetSeverityByString(strings.ToUpper(d.failOnSeverity)))) > 0
+}
+
+func (d *Diff) validateFlags() error {
+	if d.failOnSeverity != "" && !severities.Contains(strings.ToUpper(d.failOnSeverity)) {
+		return errors.New(messages.MsgErrorInvalidFailOnSeverity + d.failOnSeverity)
+	}
+
+	for _, format := range diff.Formats() {
+		if d.outputFormat == format {
+			return nil
+		}
+	}
+
+	return errors.New(messages.MsgErrorInvalidDiffOutputFormat + strings.Join(diff.Formats(), ", "))
+}
+
+func (d *Diff) writeResult(result *diff.Result) error {
+	if d.outputFilePath == "" {
+		return result.Write(d.writer, d.outputFormat, d.configs.Version, d.configs.ProjectPath)
+	}
+
+	file, err := os.Create(d.outputFilePath)
+	if err != nil {
+		return err
+	}
+
+	if err := result.Write(file, d.outputFormat, d.configs.Version, d.configs.ProjectPath); err != nil {
+		return errors.Join(err, file.Close())
+	}
+
+	return file.Close()
+}
+
+func (d *Diff) readAnalysis(path string) (*diff.Analysis, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadAnalysisFile+path, err)
+		return nil, err
+	}
+
+	entity, err := diff.ParseAnalysis(content)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadAnalysisFile+path, err)
+		return nil, err
+	}
+
+	return entity, nil
+}
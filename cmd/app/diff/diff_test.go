@@ -0,0 +1,127 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosajjal/horusec/config"
+)
+
+func TestDiff_CreateCobraCmd(t *testing.T) {
+	tmp := t.TempDir()
+	oldPath := writeAnalysis(t, filepath.Join(tmp, "old.json"),
+		newVulnerability("HS-GO-1", "hash-1", severities.High))
+	newPath := writeAnalysis(t, filepath.Join(tmp, "new.json"),
+		newVulnerability("HS-GO-1", "hash-1", severities.High),
+		newVulnerability("HS-GO-2", "hash-2", severities.Medium))
+
+	t.Run("Should return error when there are new vulnerabilities above severity", func(t *testing.T) {
+		cmd, out := newCobraCmd("--fail-on-severity", "MEDIUM", oldPath, newPath)
+
+		assert.ErrorIs(t, cmd.Execute(), ErrNewVulnerabilities)
+		assert.Contains(t, out.String(), "Vulnerabilities: 1 new, 0 fixed, 1 unchanged")
+	})
+
+	t.Run("Should return error when there are new vulnerabilities of any severity by default", func(t *testing.T) {
+		cmd, _ := newCobraCmd(oldPath, newPath)
+
+		assert.ErrorIs(t, cmd.Execute(), ErrNewVulnerabilities)
+	})
+
+	t.Run("Should not return error when new vulnerabilities are below severity", func(t *testing.T) {
+		cmd, _ := newCobraCmd("--fail-on-severity", "high", oldPath, newPath)
+
+		assert.NoError(t, cmd.Execute())
+	})
+
+	t.Run("Should write output file with output format", func(t *testing.T) {
+		outputPath := filepath.Join(tmp, "diff.md")
+		cmd, _ := newCobraCmd("-s", "CRITICAL", "-o", "markdown", "-O", outputPath, newPath, oldPath)
+
+		require.NoError(t, cmd.Execute())
+
+		content, err := os.ReadFile(outputPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "### Fixed vulnerabilities")
+	})
+
+	t.Run("Should return error when flags are invalid", func(t *testing.T) {
+		cmd, _ := newCobraCmd("-o", "html", oldPath, newPath)
+		assert.Error(t, cmd.Execute())
+
+		cmd, _ = newCobraCmd("-s", "SEVERE", oldPath, newPath)
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error when analysis file not exists", func(t *testing.T) {
+		cmd, _ := newCobraCmd(oldPath, filepath.Join(tmp, "not-exists.json"))
+		assert.Error(t, cmd.Execute())
+	})
+}
+
+func newCobraCmd(args ...string) (*cobra.Command, *bytes.Buffer) {
+	out := new(bytes.Buffer)
+	diff := NewDiffCommand(config.New())
+	diff.writer = out
+
+	cmd := diff.CreateCobraCmd()
+	// Remove the pre run hook to not configure the log file
+	cmd.PersistentPreRunE = nil
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs(args)
+
+	return cmd, out
+}
+
+func newVulnerability(ruleID, hash string, severity severities.Severity) vulnerability.Vulnerability {
+	return vulnerability.Vulnerability{
+		RuleID:   ruleID,
+		File:     "main.go",
+		Line:     "10",
+		Code:     ruleID,
+		Severity: severity,
+		Type:     enumsVulnerability.Vulnerability,
+		VulnHash: hash,
+	}
+}
+
+func writeAnalysis(t *testing.T, path string, vulns ...vulnerability.Vulnerability) string {
+	entity := analysis.Analysis{}
+	for _, vuln := range vulns {
+		entity.AnalysisVulnerabilities = append(entity.AnalysisVulnerabilities, analysis.AnalysisVulnerabilities{
+			Vulnerability: vuln,
+		})
+	}
+
+	content, err := json.Marshal(entity)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	return path
+}
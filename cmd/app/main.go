@@ -20,6 +20,8 @@ import (
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/spf13/cobra"
 
+	"github.com/mosajjal/horusec/cmd/app/baseline"
+	"github.com/mosajjal/horusec/cmd/app/diff"
 	"github.com/mosajjal/horusec/cmd/app/generate"
 	"github.com/mosajjal/horusec/cmd/app/start"
 	"github.com/mosajjal/horusec/cmd/app/version"
@@ -53,6 +55,8 @@ horusec start -p="/home/user/projects/my-project"
 
 	startCmd := start.NewStartCommand(cfg)
 	generateCmd := generate.NewGenerateCommand(cfg)
+	baselineCmd := baseline.NewBaselineCommand(cfg)
+	diffCmd := diff.NewDiffCommand(cfg)
 
 	rootCmd.PersistentFlags().
 		StringVar(
@@ -81,6 +85,8 @@ horusec start -p="/home/user/projects/my-project"
 	rootCmd.AddCommand(version.CreateCobraCmd())
 	rootCmd.AddCommand(startCmd.CreateStartCommand())
 	rootCmd.AddCommand(generateCmd.CreateCobraCmd())
+	rootCmd.AddCommand(baselineCmd.CreateCobraCmd())
+	rootCmd.AddCommand(diffCmd.CreateCobraCmd())
 
 	cobra.OnInitialize(func() {
 		engine.SetLogLevel(cfg.LogLevel)
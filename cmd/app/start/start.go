@@ -96,7 +96,7 @@ func (s *Start) CreateStartCommand() *cobra.Command {
 		StringP(
 			"output-format", "o",
 			s.configs.PrintOutputType,
-			`Output format of analysis ("text"|"json"|"sarif"|"sonarqube"). For json, sarif, and sonarqube --json-output-file is required`,
+			`Output format of analysis ("text"|"json"|"sarif"|"sonarqube"|"html"|"junit"|"gitlab-sast"|"gitlab-dependency-scanning"|"codeclimate"). For all formats except text --json-output-file is required`,
 		)
 
 	startCmd.PersistentFlags().
@@ -113,6 +113,15 @@ func (s *Start) CreateStartCommand() *cobra.Command {
 			`Output file to write analysis result. This flag should be used with --output-format`,
 		)
 
+	startCmd.PersistentFlags().
+		StringSlice(
+			"output",
+			s.configs.Outputs,
+			`Output formats of analysis with the file to write each one, that can be used to emit several formats `+
+				`in one analysis. The text summary is always printed on stdout, and --output-format is added to them. `+
+				`Example: --output sarif=out.sarif --output json=out.json --output text`,
+		)
+
 	startCmd.PersistentFlags().
 		StringSliceP(
 			"ignore", "i",
@@ -260,12 +269,68 @@ func (s *Start) CreateStartCommand() *cobra.Command {
 			`Run ShellCheck tool https://github.com/koalaman/shellcheck`,
 		)
 
+	startCmd.PersistentFlags().
+		String(
+			"since",
+			s.configs.Since,
+			`Analyze only the files changed since a git reference and report only vulnerabilities on changed lines. Example --since="origin/main"`,
+		)
+
+	startCmd.PersistentFlags().
+		Bool(
+			"changed-files",
+			s.configs.ChangedFiles,
+			"Analyze only the files with uncommitted changes and report only vulnerabilities on changed lines",
+		)
+
+	startCmd.PersistentFlags().
+		String(
+			"baseline",
+			s.configs.BaselineFilePath,
+			`Path of a baseline file created with "horusec baseline create". Vulnerabilities on baseline are set as risk accepted. Example --baseline="horusec-baseline.json"`,
+		)
+
+	startCmd.PersistentFlags().
+		String(
+			"policy",
+			s.configs.PolicyFilePath,
+			`Path of a policy file (YAML or JSON) with severity overrides by rule, rules disabled by path and risk acceptances rules. Example --policy="horusec-policy.yaml"`,
+		)
+
+	startCmd.PersistentFlags().
+		Int64(
+			"risk-acceptance-warning-days",
+			s.configs.RiskAcceptanceWarningDays,
+			"Number of days before the expiration of a risk acceptance of config file to warn about it on text output",
+		)
+
+	startCmd.PersistentFlags().
+		String(
+			"fail-on-severity",
+			s.configs.FailOnSeverity,
+			`Minimum severity of vulnerabilities that returns exit code 1 when used with --return-error. Unlike --ignore-severity, the vulnerabilities below this severity are still reported ("CRITICAL"|"HIGH"|"MEDIUM"|"LOW"|"UNKNOWN"|"INFO"). Example --fail-on-severity="HIGH"`,
+		)
+
+	startCmd.PersistentFlags().
+		StringToString(
+			"fail-on-severity-by-type",
+			s.configs.FailOnSeverityByType,
+			`Minimum severity to return exit code 1 by vulnerability type, overriding --fail-on-severity. Use "NONE" to never fail on a type. Example --fail-on-severity-by-type="Vulnerability=HIGH,Risk Accepted=NONE"`,
+		)
+
+	startCmd.PersistentFlags().
+		Bool(
+			"enable-cache",
+			s.configs.EnableCache,
+			"Cache the results of Horusec engines by file content, so unchanged files are not analyzed again on the next runs. The cache is stored on the user cache directory, e.g. ~/.cache/horusec, and entries not used for 30 days are removed",
+		)
+
 	if !dist.IsStandAlone() {
 		startCmd.PersistentFlags().
 			BoolP(
 				"disable-docker", "D",
 				s.configs.DisableDocker,
-				"Run Horusec without docker. If enabled it will only run the following tools: horusec-csharp, horusec-kotlin, horusec-java, horusec-kubernetes, horusec-leaks, horusec-javascript, horusec-dart, horusec-nginx",
+				"Run Horusec without docker. If enabled it will only run the following tools: horusec-csharp, horusec-kotlin, horusec-java, horusec-kubernetes, horusec-leaks, horusec-javascript, horusec-dart, horusec-nginx, horusec-go, horusec-python, horusec-ruby, horusec-php, horusec-c, horusec-hcl, horusec-shell, horusec-dockerfile, horusec-cicd",
 			)
 	}
 
@@ -328,7 +393,7 @@ func (s *Start) validateConfig() error {
 }
 
 func (s *Start) validateRequirements() error {
-	if s.configs.EnableGitHistoryAnalysis {
+	if s.configs.EnableGitHistoryAnalysis || s.configs.IsIncrementalAnalysis() {
 		if err := s.requirements.ValidateGit(); err != nil {
 			return err
 		}
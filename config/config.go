@@ -34,6 +34,8 @@ import (
 	"github.com/mosajjal/horusec/cmd/app/version"
 	"github.com/mosajjal/horusec/config/dist"
 	customimages "github.com/mosajjal/horusec/pkg/entities/custom_images"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
+	"github.com/mosajjal/horusec/pkg/entities/rulesconfig"
 	"github.com/mosajjal/horusec/pkg/entities/toolsconfig"
 	"github.com/mosajjal/horusec/pkg/entities/workdir"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
@@ -49,6 +51,7 @@ const (
 	EnvRepositoryAuthorization         = "HORUSEC_CLI_REPOSITORY_AUTHORIZATION"
 	EnvPrintOutputType                 = "HORUSEC_CLI_PRINT_OUTPUT_TYPE"
 	EnvJSONOutputFilePath              = "HORUSEC_CLI_JSON_OUTPUT_FILEPATH"
+	EnvOutputs                         = "HORUSEC_CLI_OUTPUTS"
 	EnvSeveritiesToIgnore              = "HORUSEC_CLI_SEVERITIES_TO_IGNORE"
 	EnvFilesOrPathsToIgnore            = "HORUSEC_CLI_FILES_OR_PATHS_TO_IGNORE"
 	EnvReturnErrorIfFoundVulnerability = "HORUSEC_CLI_RETURN_ERROR_IF_FOUND_VULNERABILITY"
@@ -62,6 +65,7 @@ const (
 	EnvFalsePositiveHashes             = "HORUSEC_CLI_FALSE_POSITIVE_HASHES"
 	EnvRiskAcceptHashes                = "HORUSEC_CLI_RISK_ACCEPT_HASHES"
 	EnvToolsConfig                     = "HORUSEC_CLI_TOOLS_CONFIG"
+	EnvRules                           = "HORUSEC_CLI_RULES"
 	EnvHeaders                         = "HORUSEC_CLI_HEADERS"
 	EnvContainerBindProjectPath        = "HORUSEC_CLI_CONTAINER_BIND_PROJECT_PATH"
 	EnvDisableDocker                   = "HORUSEC_CLI_DISABLE_DOCKER"
@@ -72,8 +76,21 @@ const (
 	EnvLogFilePath                     = "HORUSEC_CLI_LOG_FILE_PATH"
 	EnvEnableOwaspDependencyCheck      = "HORUSEC_CLI_ENABLE_OWASP_DEPENDENCY_CHECK"
 	EnvEnableShellCheck                = "HORUSEC_CLI_ENABLE_SHELLCHECK"
+	EnvSince                           = "HORUSEC_CLI_SINCE"
+	EnvChangedFiles                    = "HORUSEC_CLI_CHANGED_FILES"
+	EnvBaselineFilePath                = "HORUSEC_CLI_BASELINE_FILE_PATH"
+	EnvPolicyFilePath                  = "HORUSEC_CLI_POLICY_FILE_PATH"
+	EnvRiskAcceptances                 = "HORUSEC_CLI_RISK_ACCEPTANCES"
+	EnvRiskAcceptanceWarningDays       = "HORUSEC_CLI_RISK_ACCEPTANCE_WARNING_DAYS"
+	EnvEnableCache                     = "HORUSEC_CLI_ENABLE_CACHE"
+	EnvFailOnSeverity                  = "HORUSEC_CLI_FAIL_ON_SEVERITY"
+	EnvFailOnSeverityByType            = "HORUSEC_CLI_FAIL_ON_SEVERITY_BY_TYPE"
 )
 
+// FailOnSeverityNone is the severity threshold that never fails the analysis, which can be
+// used to never fail on a vulnerability type, e.g. {"Risk Accepted": "NONE"}.
+const FailOnSeverityNone = "NONE"
+
 type GlobalOptions struct {
 	// TODO: Remove this field.
 	// IsTimeout is not a configuration value.
@@ -87,35 +104,46 @@ type GlobalOptions struct {
 }
 
 type StartOptions struct {
-	HorusecAPIUri                   string                    `json:"horusec_api_uri"`
-	RepositoryAuthorization         string                    `json:"repository_authorization"`
-	CertPath                        string                    `json:"cert_path"`
-	RepositoryName                  string                    `json:"repository_name"`
-	PrintOutputType                 string                    `json:"print_output_type"`
-	JSONOutputFilePath              string                    `json:"json_output_file_path"`
-	ProjectPath                     string                    `json:"project_path"`
-	CustomRulesPath                 string                    `json:"custom_rules_path"`
-	ContainerBindProjectPath        string                    `json:"container_bind_project_path"`
-	TimeoutInSecondsRequest         int64                     `json:"timeout_in_seconds_request"`
-	TimeoutInSecondsAnalysis        int64                     `json:"timeout_in_seconds_analysis"`
-	MonitorRetryInSeconds           int64                     `json:"monitor_retry_in_seconds"`
-	ReturnErrorIfFoundVulnerability bool                      `json:"return_error_if_found_vulnerability"`
-	EnableGitHistoryAnalysis        bool                      `json:"enable_git_history_analysis"`
-	CertInsecureSkipVerify          bool                      `json:"cert_insecure_skip_verify"`
-	EnableCommitAuthor              bool                      `json:"enable_commit_author"`
-	DisableDocker                   bool                      `json:"disable_docker"`
-	EnableInformationSeverity       bool                      `json:"enable_information_severity"`
-	EnableOwaspDependencyCheck      bool                      `json:"enable_owasp_dependency_check"`
-	EnableShellCheck                bool                      `json:"enable_shell_check"`
-	SeveritiesToIgnore              []string                  `json:"severities_to_ignore"`
-	FilesOrPathsToIgnore            []string                  `json:"files_or_paths_to_ignore"`
-	FalsePositiveHashes             []string                  `json:"false_positive_hashes"`
-	RiskAcceptHashes                []string                  `json:"risk_accept_hashes"`
-	ShowVulnerabilitiesTypes        []string                  `json:"show_vulnerabilities_types"`
-	ToolsConfig                     toolsconfig.ToolsConfig   `json:"tools_config"`
-	Headers                         map[string]string         `json:"headers"`
-	WorkDir                         *workdir.WorkDir          `json:"work_dir"`
-	CustomImages                    customimages.CustomImages `json:"custom_images"`
+	HorusecAPIUri                   string                         `json:"horusec_api_uri"`
+	RepositoryAuthorization         string                         `json:"repository_authorization"`
+	CertPath                        string                         `json:"cert_path"`
+	RepositoryName                  string                         `json:"repository_name"`
+	PrintOutputType                 string                         `json:"print_output_type"`
+	JSONOutputFilePath              string                         `json:"json_output_file_path"`
+	ProjectPath                     string                         `json:"project_path"`
+	CustomRulesPath                 string                         `json:"custom_rules_path"`
+	ContainerBindProjectPath        string                         `json:"container_bind_project_path"`
+	Since                           string                         `json:"since"`
+	BaselineFilePath                string                         `json:"baseline_file_path"`
+	FailOnSeverity                  string                         `json:"fail_on_severity"`
+	PolicyFilePath                  string                         `json:"policy_file_path"`
+	TimeoutInSecondsRequest         int64                          `json:"timeout_in_seconds_request"`
+	TimeoutInSecondsAnalysis        int64                          `json:"timeout_in_seconds_analysis"`
+	MonitorRetryInSeconds           int64                          `json:"monitor_retry_in_seconds"`
+	RiskAcceptanceWarningDays       int64                          `json:"risk_acceptance_warning_days"`
+	ReturnErrorIfFoundVulnerability bool                           `json:"return_error_if_found_vulnerability"`
+	EnableGitHistoryAnalysis        bool                           `json:"enable_git_history_analysis"`
+	CertInsecureSkipVerify          bool                           `json:"cert_insecure_skip_verify"`
+	EnableCommitAuthor              bool                           `json:"enable_commit_author"`
+	DisableDocker                   bool                           `json:"disable_docker"`
+	EnableInformationSeverity       bool                           `json:"enable_information_severity"`
+	EnableOwaspDependencyCheck      bool                           `json:"enable_owasp_dependency_check"`
+	EnableShellCheck                bool                           `json:"enable_shell_check"`
+	ChangedFiles                    bool                           `json:"changed_files"`
+	EnableCache                     bool                           `json:"enable_cache"`
+	Outputs                         []string                       `json:"outputs"`
+	SeveritiesToIgnore              []string                       `json:"severities_to_ignore"`
+	FilesOrPathsToIgnore            []string                       `json:"files_or_paths_to_ignore"`
+	FalsePositiveHashes             []string                       `json:"false_positive_hashes"`
+	RiskAcceptHashes                []string                       `json:"risk_accept_hashes"`
+	RiskAcceptances                 riskacceptance.RiskAcceptances `json:"risk_acceptances"`
+	ShowVulnerabilitiesTypes        []string                       `json:"show_vulnerabilities_types"`
+	ToolsConfig                     toolsconfig.ToolsConfig        `json:"tools_config"`
+	Rules                           rulesconfig.RulesConfig        `json:"rules"`
+	Headers                         map[string]string              `json:"headers"`
+	FailOnSeverityByType            map[string]string              `json:"fail_on_severity_by_type"`
+	WorkDir                         *workdir.WorkDir               `json:"work_dir"`
+	CustomImages                    customimages.CustomImages      `json:"custom_images"`
 }
 
 type Config struct {
@@ -148,9 +176,11 @@ func New() *Config {
 			TimeoutInSecondsRequest:         300,
 			TimeoutInSecondsAnalysis:        600,
 			MonitorRetryInSeconds:           15,
+			RiskAcceptanceWarningDays:       30,
 			RepositoryAuthorization:         uuid.Nil.String(),
 			PrintOutputType:                 "",
 			JSONOutputFilePath:              "",
+			Outputs:                         make([]string, 0),
 			SeveritiesToIgnore:              []string{"INFO"},
 			FilesOrPathsToIgnore:            []string{"*tmp*", "**/.vscode/**"},
 			ReturnErrorIfFoundVulnerability: false,
@@ -162,10 +192,13 @@ func New() *Config {
 			EnableCommitAuthor:              false,
 			RepositoryName:                  filepath.Base(wd),
 			RiskAcceptHashes:                make([]string, 0),
+			RiskAcceptances:                 make(riskacceptance.RiskAcceptances, 0),
 			FalsePositiveHashes:             make([]string, 0),
 			Headers:                         make(map[string]string),
+			FailOnSeverityByType:            make(map[string]string),
 			ContainerBindProjectPath:        "",
 			ToolsConfig:                     toolsconfig.Default(),
+			Rules:                           make(rulesconfig.RulesConfig),
 			ShowVulnerabilitiesTypes:        []string{vulnerability.Vulnerability.ToString()},
 			CustomImages:                    customimages.Default(),
 			DisableDocker:                   dist.IsStandAlone(),
@@ -173,6 +206,11 @@ func New() *Config {
 			EnableInformationSeverity:       false,
 			EnableOwaspDependencyCheck:      false,
 			EnableShellCheck:                false,
+			Since:                           "",
+			ChangedFiles:                    false,
+			BaselineFilePath:                "",
+			PolicyFilePath:                  "",
+			EnableCache:                     false,
 		},
 	}
 }
@@ -192,6 +230,7 @@ func (c *Config) LoadStartFlags(cmd *cobra.Command) *Config {
 	c.MonitorRetryInSeconds = c.extractFlagValueInt64(cmd, "monitor-retry-count", c.MonitorRetryInSeconds)
 	c.PrintOutputType = c.extractFlagValueString(cmd, "output-format", c.PrintOutputType)
 	c.JSONOutputFilePath = c.extractFlagValueString(cmd, "json-output-file", c.JSONOutputFilePath)
+	c.Outputs = c.extractFlagValueStringSlice(cmd, "output", c.Outputs)
 	c.SeveritiesToIgnore = c.extractFlagValueStringSlice(cmd, "ignore-severity", c.SeveritiesToIgnore)
 	c.FilesOrPathsToIgnore = c.extractFlagValueStringSlice(cmd, "ignore", c.FilesOrPathsToIgnore)
 	c.HorusecAPIUri = c.extractFlagValueString(cmd, "horusec-url", c.HorusecAPIUri)
@@ -221,6 +260,16 @@ func (c *Config) LoadStartFlags(cmd *cobra.Command) *Config {
 		cmd, "enable-owasp-dependency-check", c.EnableOwaspDependencyCheck,
 	)
 	c.EnableShellCheck = c.extractFlagValueBool(cmd, "enable-shellcheck", c.EnableShellCheck)
+	c.Since = c.extractFlagValueString(cmd, "since", c.Since)
+	c.ChangedFiles = c.extractFlagValueBool(cmd, "changed-files", c.ChangedFiles)
+	c.BaselineFilePath = c.extractFlagValueString(cmd, "baseline", c.BaselineFilePath)
+	c.PolicyFilePath = c.extractFlagValueString(cmd, "policy", c.PolicyFilePath)
+	c.RiskAcceptanceWarningDays = c.extractFlagValueInt64(
+		cmd, "risk-acceptance-warning-days", c.RiskAcceptanceWarningDays,
+	)
+	c.EnableCache = c.extractFlagValueBool(cmd, "enable-cache", c.EnableCache)
+	c.FailOnSeverity = c.extractFlagValueString(cmd, "fail-on-severity", c.FailOnSeverity)
+	c.FailOnSeverityByType = c.extractFlagValueStringToString(cmd, "fail-on-severity-by-type", c.FailOnSeverityByType)
 	return c
 }
 
@@ -254,6 +303,9 @@ func (c *Config) LoadFromConfigFile() *Config {
 	c.JSONOutputFilePath = valueordefault.GetStringValueOrDefault(
 		viper.GetString(c.toLowerCamel(EnvJSONOutputFilePath)), c.JSONOutputFilePath,
 	)
+	c.Outputs = valueordefault.GetSliceStringValueOrDefault(
+		viper.GetStringSlice(c.toLowerCamel(EnvOutputs)), c.Outputs,
+	)
 	c.SeveritiesToIgnore = valueordefault.GetSliceStringValueOrDefault(
 		viper.GetStringSlice(c.toLowerCamel(EnvSeveritiesToIgnore)), c.SeveritiesToIgnore,
 	)
@@ -298,6 +350,10 @@ func (c *Config) LoadFromConfigFile() *Config {
 		c.ToolsConfig = toolsconfig.MustParseToolsConfig(cfg)
 	}
 
+	if rules := viper.GetStringMap(c.toLowerCamel(EnvRules)); len(rules) > 0 {
+		c.Rules = rulesconfig.MustParseRulesConfig(rules)
+	}
+
 	c.DisableDocker = viper.GetBool(c.toLowerCamel(EnvDisableDocker))
 	c.CustomRulesPath = valueordefault.GetStringValueOrDefault(
 		viper.GetString(c.toLowerCamel(EnvCustomRulesPath)), c.CustomRulesPath,
@@ -316,6 +372,29 @@ func (c *Config) LoadFromConfigFile() *Config {
 	)
 	c.EnableOwaspDependencyCheck = viper.GetBool(c.toLowerCamel(EnvEnableOwaspDependencyCheck))
 	c.EnableShellCheck = viper.GetBool(c.toLowerCamel(EnvEnableShellCheck))
+	c.Since = valueordefault.GetStringValueOrDefault(viper.GetString(c.toLowerCamel(EnvSince)), c.Since)
+	c.ChangedFiles = viper.GetBool(c.toLowerCamel(EnvChangedFiles))
+	c.EnableCache = viper.GetBool(c.toLowerCamel(EnvEnableCache))
+	c.FailOnSeverity = valueordefault.GetStringValueOrDefault(
+		viper.GetString(c.toLowerCamel(EnvFailOnSeverity)), c.FailOnSeverity,
+	)
+	failOnSeverityByType := viper.GetStringMapString(c.toLowerCamel(EnvFailOnSeverityByType))
+	if len(failOnSeverityByType) > 0 {
+		c.FailOnSeverityByType = failOnSeverityByType
+	}
+	c.BaselineFilePath = valueordefault.GetStringValueOrDefault(
+		viper.GetString(c.toLowerCamel(EnvBaselineFilePath)), c.BaselineFilePath,
+	)
+	c.PolicyFilePath = valueordefault.GetStringValueOrDefault(
+		viper.GetString(c.toLowerCamel(EnvPolicyFilePath)), c.PolicyFilePath,
+	)
+	if acceptances := viper.Get(c.toLowerCamel(EnvRiskAcceptances)); acceptances != nil {
+		c.RiskAcceptances = riskacceptance.MustParseRiskAcceptances(acceptances)
+	}
+	// Zero is a valid value that disables the warnings, so only a missing key keeps the default.
+	if viper.IsSet(c.toLowerCamel(EnvRiskAcceptanceWarningDays)) {
+		c.RiskAcceptanceWarningDays = viper.GetInt64(c.toLowerCamel(EnvRiskAcceptanceWarningDays))
+	}
 	return c
 }
 
@@ -333,6 +412,8 @@ func (c *Config) LoadFromEnvironmentVariables() *Config {
 	c.PrintOutputType = env.GetEnvOrDefault(EnvPrintOutputType, c.PrintOutputType)
 	c.JSONOutputFilePath = env.GetEnvOrDefault(EnvJSONOutputFilePath, c.JSONOutputFilePath)
 
+	c.Outputs = c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvOutputs, c.Outputs))
+
 	c.SeveritiesToIgnore = c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvSeveritiesToIgnore, c.SeveritiesToIgnore))
 
 	c.FilesOrPathsToIgnore = c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvFilesOrPathsToIgnore, c.FilesOrPathsToIgnore))
@@ -365,6 +446,24 @@ func (c *Config) LoadFromEnvironmentVariables() *Config {
 	c.LogFilePath = env.GetEnvOrDefault(EnvLogFilePath, c.LogFilePath)
 	c.EnableOwaspDependencyCheck = env.GetEnvOrDefaultBool(EnvEnableOwaspDependencyCheck, c.EnableOwaspDependencyCheck)
 	c.EnableShellCheck = env.GetEnvOrDefaultBool(EnvEnableShellCheck, c.EnableShellCheck)
+	c.Since = env.GetEnvOrDefault(EnvSince, c.Since)
+	c.ChangedFiles = env.GetEnvOrDefaultBool(EnvChangedFiles, c.ChangedFiles)
+	c.BaselineFilePath = env.GetEnvOrDefault(EnvBaselineFilePath, c.BaselineFilePath)
+	c.PolicyFilePath = env.GetEnvOrDefault(EnvPolicyFilePath, c.PolicyFilePath)
+	if acceptances := env.GetEnvOrDefault(EnvRiskAcceptances, ""); acceptances != "" {
+		c.RiskAcceptances = riskacceptance.MustParseRiskAcceptances(acceptances)
+	}
+	c.RiskAcceptanceWarningDays = env.GetEnvOrDefaultInt64(EnvRiskAcceptanceWarningDays, c.RiskAcceptanceWarningDays)
+	if rules := env.GetEnvOrDefault(EnvRules, ""); rules != "" {
+		c.Rules = rulesconfig.MustParseRulesConfig(rules)
+	}
+	c.EnableCache = env.GetEnvOrDefaultBool(EnvEnableCache, c.EnableCache)
+	c.FailOnSeverity = env.GetEnvOrDefault(EnvFailOnSeverity, c.FailOnSeverity)
+	if v := env.GetEnvOrDefaultInterface(EnvFailOnSeverityByType, c.FailOnSeverityByType); v != nil {
+		failOnSeverityByType, err := jsonutils.ConvertInterfaceToMapString(v)
+		logger.LogErrorWithLevel(messages.MsgErrorSetFailOnSeverityByTypeOnConfig, err)
+		c.FailOnSeverityByType = failOnSeverityByType
+	}
 	return c
 }
 
@@ -406,6 +505,12 @@ func (c *Config) ConfigureLogger() error {
 	return nil
 }
 
+// IsIncrementalAnalysis return true if only the files changed since a git
+// reference should be analyzed.
+func (c *Config) IsIncrementalAnalysis() bool {
+	return c.Since != "" || c.ChangedFiles
+}
+
 func (c *Config) IsEmptyRepositoryAuthorization() bool {
 	return c.RepositoryAuthorization == "" || c.RepositoryAuthorization == uuid.Nil.String()
 }
@@ -436,6 +541,7 @@ func (c *Config) ToMapLowerCase() map[string]interface{} {
 		c.toLowerCamel(EnvRepositoryAuthorization):         c.RepositoryAuthorization,
 		c.toLowerCamel(EnvPrintOutputType):                 c.PrintOutputType,
 		c.toLowerCamel(EnvJSONOutputFilePath):              c.JSONOutputFilePath,
+		c.toLowerCamel(EnvOutputs):                         c.Outputs,
 		c.toLowerCamel(EnvSeveritiesToIgnore):              c.SeveritiesToIgnore,
 		c.toLowerCamel(EnvFilesOrPathsToIgnore):            c.FilesOrPathsToIgnore,
 		c.toLowerCamel(EnvReturnErrorIfFoundVulnerability): c.ReturnErrorIfFoundVulnerability,
@@ -451,6 +557,7 @@ func (c *Config) ToMapLowerCase() map[string]interface{} {
 		c.toLowerCamel(EnvHeaders):                         c.Headers,
 		c.toLowerCamel(EnvContainerBindProjectPath):        c.ContainerBindProjectPath,
 		c.toLowerCamel(EnvToolsConfig):                     c.ToolsConfig,
+		c.toLowerCamel(EnvRules):                           c.Rules,
 		c.toLowerCamel(EnvDisableDocker):                   c.DisableDocker,
 		c.toLowerCamel(EnvCustomRulesPath):                 c.CustomRulesPath,
 		c.toLowerCamel(EnvEnableInformationSeverity):       c.EnableInformationSeverity,
@@ -459,6 +566,15 @@ func (c *Config) ToMapLowerCase() map[string]interface{} {
 		c.toLowerCamel(EnvLogFilePath):                     c.LogFilePath,
 		c.toLowerCamel(EnvEnableOwaspDependencyCheck):      c.EnableOwaspDependencyCheck,
 		c.toLowerCamel(EnvEnableShellCheck):                c.EnableShellCheck,
+		c.toLowerCamel(EnvSince):                           c.Since,
+		c.toLowerCamel(EnvChangedFiles):                    c.ChangedFiles,
+		c.toLowerCamel(EnvBaselineFilePath):                c.BaselineFilePath,
+		c.toLowerCamel(EnvEnableCache):                     c.EnableCache,
+		c.toLowerCamel(EnvFailOnSeverity):                  c.FailOnSeverity,
+		c.toLowerCamel(EnvFailOnSeverityByType):            c.FailOnSeverityByType,
+		c.toLowerCamel(EnvPolicyFilePath):                  c.PolicyFilePath,
+		c.toLowerCamel(EnvRiskAcceptances):                 c.RiskAcceptances,
+		c.toLowerCamel(EnvRiskAcceptanceWarningDays):       c.RiskAcceptanceWarningDays,
 	}
 }
 
@@ -467,6 +583,12 @@ func (c *Config) Normalize() *Config {
 	if c.JSONOutputFilePath != "" {
 		c.JSONOutputFilePath, _ = filepath.Abs(c.JSONOutputFilePath)
 	}
+	if c.BaselineFilePath != "" {
+		c.BaselineFilePath, _ = filepath.Abs(c.BaselineFilePath)
+	}
+	if c.PolicyFilePath != "" {
+		c.PolicyFilePath, _ = filepath.Abs(c.PolicyFilePath)
+	}
 	c.ProjectPath, _ = filepath.Abs(c.ProjectPath)
 	c.ConfigFilePath, _ = filepath.Abs(c.ConfigFilePath)
 	c.LogFilePath, _ = filepath.Abs(c.LogFilePath)
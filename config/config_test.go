@@ -33,6 +33,8 @@ import (
 
 	"github.com/mosajjal/horusec/cmd/app/start"
 	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
+	"github.com/mosajjal/horusec/pkg/entities/rulesconfig"
 	"github.com/mosajjal/horusec/pkg/entities/toolsconfig"
 	"github.com/mosajjal/horusec/pkg/entities/workdir"
 )
@@ -187,6 +189,13 @@ func TestNewHorusecConfig(t *testing.T) {
 		assert.NoError(t, os.Setenv(config.EnvEnableShellCheck, "true"))
 		assert.NoError(t, os.Setenv(config.EnvCustomRulesPath, "test"))
 		assert.NoError(t, os.Setenv(config.EnvEnableInformationSeverity, "true"))
+		t.Setenv(config.EnvFailOnSeverity, "HIGH")
+		t.Setenv(config.EnvFailOnSeverityByType, "{\"Risk Accepted\": \"NONE\"}")
+		t.Setenv(config.EnvPolicyFilePath, "horusec-policy.yaml")
+		t.Setenv(config.EnvRiskAcceptances, `[{"hash": "hash5", "expires_at": "2026-12-31", "owner": "me", "ticket": "SEC-1"}]`)
+		t.Setenv(config.EnvRiskAcceptanceWarningDays, "7")
+		t.Setenv(config.EnvRules, `{"G104": {"disabled": true}}`)
+		t.Setenv(config.EnvOutputs, "sarif=out.sarif, text")
 		assert.NoError(t, os.Setenv(
 			config.EnvLogFilePath, filepath.Join(os.TempDir(), "test.log")),
 		)
@@ -219,6 +228,15 @@ func TestNewHorusecConfig(t *testing.T) {
 		assert.Equal(t, true, configs.EnableInformationSeverity)
 		assert.Equal(t, true, configs.EnableOwaspDependencyCheck)
 		assert.Equal(t, true, configs.EnableShellCheck)
+		assert.Equal(t, "HIGH", configs.FailOnSeverity)
+		assert.Equal(t, map[string]string{"Risk Accepted": "NONE"}, configs.FailOnSeverityByType)
+		assert.Equal(t, "horusec-policy.yaml", configs.PolicyFilePath)
+		assert.Equal(t, riskacceptance.RiskAcceptances{
+			{Hash: "hash5", ExpiresAt: "2026-12-31", Owner: "me", Ticket: "SEC-1"},
+		}, configs.RiskAcceptances)
+		assert.Equal(t, int64(7), configs.RiskAcceptanceWarningDays)
+		assert.Equal(t, rulesconfig.RulesConfig{"G104": {Disabled: true}}, configs.Rules)
+		assert.Equal(t, []string{"sarif=out.sarif", "text"}, configs.Outputs)
 		assert.Equal(
 			t,
 			[]string{vulnerability.Vulnerability.ToString(), vulnerability.FalsePositive.ToString()},
@@ -351,6 +369,8 @@ func TestNewHorusecConfig(t *testing.T) {
 			"--json-output-file", "./tmp/json-output-file-test.json",
 			"--monitor-retry-count", "123",
 			"--output-format", "json",
+			"--output", "sarif=" + filepath.Join(target, "out.sarif"),
+			"--output", "json=" + filepath.Join(target, "out.json"),
 			"--repository-name", "repository-name-test",
 			"--request-timeout", "123",
 			"--return-error", "true",
@@ -380,6 +400,9 @@ func TestNewHorusecConfig(t *testing.T) {
 		assert.Equal(t, filepath.Join(wd, "tmp", "json-output-file-test.json"), configs.JSONOutputFilePath)
 		assert.Equal(t, int64(123), configs.MonitorRetryInSeconds)
 		assert.Equal(t, "json", configs.PrintOutputType)
+		assert.Equal(t, []string{
+			"sarif=" + filepath.Join(target, "out.sarif"), "json=" + filepath.Join(target, "out.json"),
+		}, configs.Outputs)
 		assert.Equal(t, "repository-name-test", configs.RepositoryName)
 		assert.Equal(t, int64(123), configs.TimeoutInSecondsRequest)
 		assert.Equal(t, true, configs.ReturnErrorIfFoundVulnerability)
@@ -457,9 +480,14 @@ func TestConfig_Bytes(t *testing.T) {
   "project_path": "./horusec-manager",
   "custom_rules_path": "test",
   "container_bind_project_path": "./my-path",
+  "since": "",
+  "baseline_file_path": "",
+  "fail_on_severity": "",
+  "policy_file_path": "",
   "timeout_in_seconds_request": 99,
   "timeout_in_seconds_analysis": 999,
   "monitor_retry_in_seconds": 20,
+  "risk_acceptance_warning_days": 30,
   "return_error_if_found_vulnerability": false,
   "enable_git_history_analysis": false,
   "cert_insecure_skip_verify": false,
@@ -468,6 +496,9 @@ func TestConfig_Bytes(t *testing.T) {
   "enable_information_severity": true,
   "enable_owasp_dependency_check": true,
   "enable_shell_check": true,
+  "changed_files": false,
+  "enable_cache": false,
+  "outputs": null,
   "severities_to_ignore": [
     "INFO"
   ],
@@ -483,6 +514,7 @@ func TestConfig_Bytes(t *testing.T) {
     "hash7",
     "hash6"
   ],
+  "risk_acceptances": [],
   "show_vulnerabilities_types": [
     "Vulnerability",
     "Risk Accepted"
@@ -555,9 +587,11 @@ func TestConfig_Bytes(t *testing.T) {
       "istoignore": false
     }
   },
+  "rules": {},
   "headers": {
     "x-auth": "987654321"
   },
+  "fail_on_severity_by_type": {},
   "work_dir": {
     "go": [],
     "csharp": [],
@@ -612,9 +646,14 @@ func TestConfig_Bytes(t *testing.T) {
   "project_path": "",
   "custom_rules_path": "",
   "container_bind_project_path": "",
+  "since": "",
+  "baseline_file_path": "",
+  "fail_on_severity": "",
+  "policy_file_path": "",
   "timeout_in_seconds_request": 0,
   "timeout_in_seconds_analysis": 0,
   "monitor_retry_in_seconds": 0,
+  "risk_acceptance_warning_days": 0,
   "return_error_if_found_vulnerability": false,
   "enable_git_history_analysis": false,
   "cert_insecure_skip_verify": false,
@@ -623,13 +662,19 @@ func TestConfig_Bytes(t *testing.T) {
   "enable_information_severity": false,
   "enable_owasp_dependency_check": false,
   "enable_shell_check": false,
+  "changed_files": false,
+  "enable_cache": false,
+  "outputs": null,
   "severities_to_ignore": null,
   "files_or_paths_to_ignore": null,
   "false_positive_hashes": null,
   "risk_accept_hashes": null,
+  "risk_acceptances": null,
   "show_vulnerabilities_types": null,
   "tools_config": null,
+  "rules": null,
   "headers": null,
+  "fail_on_severity_by_type": null,
   "work_dir": null,
   "custom_images": null,
   "version": ""
@@ -655,3 +700,53 @@ func TestSetLogOutput(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestRiskAcceptanceWarningDays(t *testing.T) {
+	t.Run("Should disable the warnings when config file sets zero days", func(t *testing.T) {
+		viper.Reset()
+		configFilePath := filepath.Join(t.TempDir(), "horusec-config.json")
+		assert.NoError(t, os.WriteFile(configFilePath, []byte(`{"horusecCliRiskAcceptanceWarningDays": 0}`), 0o600))
+
+		configs := config.New()
+		configs.ConfigFilePath = configFilePath
+		configs.LoadFromConfigFile()
+
+		assert.Equal(t, int64(0), configs.RiskAcceptanceWarningDays)
+	})
+
+	t.Run("Should keep the default days when config file does not set them", func(t *testing.T) {
+		viper.Reset()
+		configFilePath := filepath.Join(t.TempDir(), "horusec-config.json")
+		assert.NoError(t, os.WriteFile(configFilePath, []byte(`{"horusecCliRepositoryName": "horus"}`), 0o600))
+
+		configs := config.New()
+		configs.ConfigFilePath = configFilePath
+		configs.LoadFromConfigFile()
+
+		assert.Equal(t, int64(30), configs.RiskAcceptanceWarningDays)
+	})
+}
+
+func TestIsIncrementalAnalysis(t *testing.T) {
+	t.Run("Should not be incremental analysis by default", func(t *testing.T) {
+		assert.False(t, config.New().IsIncrementalAnalysis())
+	})
+
+	t.Run("Should be incremental analysis when since is set by environment variable", func(t *testing.T) {
+		t.Setenv(config.EnvSince, "origin/main")
+
+		cfg := config.New().LoadFromEnvironmentVariables()
+
+		assert.Equal(t, "origin/main", cfg.Since)
+		assert.True(t, cfg.IsIncrementalAnalysis())
+	})
+
+	t.Run("Should be incremental analysis when changed files is set by environment variable", func(t *testing.T) {
+		t.Setenv(config.EnvChangedFiles, "true")
+
+		cfg := config.New().LoadFromEnvironmentVariables()
+
+		assert.True(t, cfg.ChangedFiles)
+		assert.True(t, cfg.IsIncrementalAnalysis())
+	})
+}
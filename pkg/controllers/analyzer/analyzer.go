@@ -16,6 +16,8 @@ package analyzer
 
 import (
 	"fmt"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,23 +34,41 @@ import (
 	"github.com/mosajjal/horusec/config"
 	languagedetect "github.com/mosajjal/horusec/pkg/controllers/language_detect"
 	"github.com/mosajjal/horusec/pkg/controllers/printresults"
+	"github.com/mosajjal/horusec/pkg/entities/baseline"
+	"github.com/mosajjal/horusec/pkg/entities/policy"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
 	"github.com/mosajjal/horusec/pkg/services/docker"
 	"github.com/mosajjal/horusec/pkg/services/docker/client"
+	"github.com/mosajjal/horusec/pkg/services/git"
 	horusec_api "github.com/mosajjal/horusec/pkg/services/horusec_api"
+	"github.com/mosajjal/horusec/pkg/services/suppression"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
 )
 
 // LanguageDetect is the interface that detect all languages in some directory.
+//
+// SetChanges set the changes of an incremental analysis, so only the changed files are
+// detected and copied.
 type LanguageDetect interface {
 	Detect(directory string) ([]languages.Language, error)
+	SetChanges(changes git.Changes)
 }
 
 // PrintResults is the interface tha print the results to stdout
 //
 // Print print the results to stdout and return the total vulnerabilities that was printed.
+//
+// SetSuppressedVulnerabilities set the vulnerabilities suppressed by an inline comment,
+// which are only written on the JSON output.
+//
+// SetRiskAcceptances set the risk acceptances of the policy file, that are warned when
+// expiring soon together with the ones of config.
 type PrintResults interface {
 	Print() (int, error)
 	SetAnalysis(analysis *analysis.Analysis)
+	SetSuppressedVulnerabilities(vulnerabilities []analysis.AnalysisVulnerabilities)
+	SetRiskAcceptances(acceptances riskacceptance.RiskAcceptances)
 }
 
 // HorusecService is the interface that interacts with Horusec API
@@ -74,6 +94,14 @@ type Analyzer struct {
 	printController PrintResults
 	horusec         HorusecService
 	runner          *runner
+	baseline        *baseline.Baseline
+	baselineHashes  []string
+	policy          *policy.Policy
+	fingerprinter   *vulnhash.Fingerprinter
+	changes         git.Changes
+
+	suppressions              suppression.Set
+	suppressedVulnerabilities []analysis.AnalysisVulnerabilities
 }
 
 // New create a new analyzer to a given config.
@@ -91,6 +119,7 @@ func New(cfg *config.Config) *Analyzer {
 		printController: printresults.NewPrintResults(analysiss, cfg),
 		horusec:         horusec_api.NewHorusecAPIService(cfg),
 		runner:          newRunner(cfg, analysiss, dockerAPI),
+		fingerprinter:   vulnhash.NewFingerprinter(cfg.ProjectPath),
 	}
 }
 
@@ -99,6 +128,18 @@ func New(cfg *config.Config) *Analyzer {
 //
 // nolint: funlen
 func (a *Analyzer) Analyze() (int, error) {
+	if err := a.loadBaseline(); err != nil {
+		return 0, err
+	}
+
+	if err := a.loadPolicy(); err != nil {
+		return 0, err
+	}
+
+	if err := a.loadChanges(); err != nil {
+		return 0, err
+	}
+
 	langs, err := a.languageDetect.Detect(a.config.ProjectPath)
 	if err != nil {
 		return 0, err
@@ -113,6 +154,12 @@ func (a *Analyzer) Analyze() (int, error) {
 		a.setAnalysisError(err)
 	}
 
+	a.suppressVulnerabilities()
+
+	if a.config.IsIncrementalAnalysis() {
+		a.removeVulnerabilitiesOutOfChangedLines()
+	}
+
 	if err = a.sendAnalysis(); err != nil {
 		logger.LogStringAsError(fmt.Sprintf("[HORUSEC] %s", err.Error()))
 	}
@@ -120,9 +167,48 @@ func (a *Analyzer) Analyze() (int, error) {
 	return a.startPrintResults()
 }
 
+// loadChanges load the files and lines changed since the git reference of an incremental
+// analysis. They are loaded only once, and shared with the language detect to skip the
+// unchanged files.
+func (a *Analyzer) loadChanges() error {
+	if !a.config.IsIncrementalAnalysis() {
+		return nil
+	}
+
+	changes, err := git.New(a.config).Changes()
+	if err != nil {
+		return err
+	}
+
+	a.changes = changes
+	a.languageDetect.SetChanges(changes)
+
+	return nil
+}
+
+// suppressVulnerabilities set as false positive the vulnerabilities with a horusec:ignore
+// comment to their rule on their line or on the preceding one, adding the reason of the
+// suppression on their details. The suppressed vulnerabilities are kept on a set, so they
+// are never changed by the false positive and risk accept hashes and could be audited.
+func (a *Analyzer) suppressVulnerabilities() {
+	finder := suppression.NewFinder()
+	a.suppressions = suppression.Set{}
+
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vuln := &a.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if finder.Suppress(a.config.ProjectPath, vuln) {
+			a.suppressions.Add(vuln)
+		}
+	}
+}
+
 func (a *Analyzer) startPrintResults() (int, error) {
 	a.formatAnalysisToPrint()
 	a.printController.SetAnalysis(a.analysis)
+	a.printController.SetSuppressedVulnerabilities(a.suppressedVulnerabilities)
+	if a.policy != nil {
+		a.printController.SetRiskAcceptances(a.policy.RiskAcceptances)
+	}
 	return a.printController.Print()
 }
 
@@ -145,6 +231,7 @@ func (a *Analyzer) formatAnalysisToPrint() {
 	a.setUpdateHashWarnings()
 
 	a.analysis = a.setFalsePositive()
+	a.applyPolicy()
 	if !a.config.EnableInformationSeverity {
 		a.analysis = a.removeInfoVulnerabilities()
 	}
@@ -166,47 +253,121 @@ func (a *Analyzer) formatAnalysisToSendToAPI() {
 }
 
 // logWarnIfHashDontExistsInConfig logs a warning if the one of the config hashes don't exist in the analysis.
-// nolint
 func (a *Analyzer) logWarnIfHashDontExistsInConfig(configHashes []string) {
-	for _, configHash := range configHashes {
-		exists := false
+	hashes := a.getAnalysisHashes()
 
-		for vulnIndex := range a.analysis.AnalysisVulnerabilities {
-			// See vulnerability.Vulnerability.DeprecatedHashes docs for more info.
-			hashes := make([]string, len(a.analysis.AnalysisVulnerabilities[vulnIndex].Vulnerability.DeprecatedHashes)+1)
-			hashes = append(hashes, a.analysis.AnalysisVulnerabilities[vulnIndex].Vulnerability.DeprecatedHashes...)
-			hashes = append(hashes, a.analysis.AnalysisVulnerabilities[vulnIndex].Vulnerability.VulnHash)
-			if a.contains(hashes, configHash) {
-				exists = true
-				break
-			}
+	for _, configHash := range configHashes {
+		if !hashes[configHash] {
+			logger.LogWarnWithLevel(messages.MsgWarnHashNotExistOnAnalysis + configHash)
 		}
+	}
+}
 
-		if !exists {
-			logger.LogWarnWithLevel(messages.MsgWarnHashNotExistOnAnalysis + configHash)
+// getAnalysisHashes return a set with the hash, the deprecated hashes and the fingerprint
+// of all vulnerabilities of the analysis, generating each fingerprint only once.
+func (a *Analyzer) getAnalysisHashes() map[string]bool {
+	hashes := make(map[string]bool)
+
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vuln := &a.analysis.AnalysisVulnerabilities[index].Vulnerability
+
+		// See vulnerability.Vulnerability.DeprecatedHashes docs for more info.
+		for _, hash := range vuln.DeprecatedHashes {
+			hashes[hash] = true
 		}
+
+		hashes[vuln.VulnHash] = true
+		hashes[a.fingerprinter.Fingerprint(vuln)] = true
 	}
+
+	return hashes
 }
 
-func (a *Analyzer) contains(hashes []string, hash string) bool {
-	for _, v := range hashes {
-		if hash == v {
-			return true
+func (a *Analyzer) loadBaseline() error {
+	if a.config.BaselineFilePath == "" {
+		return nil
+	}
+
+	entity, err := baseline.Parse(a.config.BaselineFilePath)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadBaselineFile+a.config.BaselineFilePath, err)
+		return err
+	}
+
+	a.baseline = entity
+
+	return nil
+}
+
+func (a *Analyzer) loadPolicy() error {
+	if a.config.PolicyFilePath == "" {
+		return nil
+	}
+
+	entity, err := policy.Parse(a.config.PolicyFilePath)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadPolicyFile+a.config.PolicyFilePath, err)
+		return err
+	}
+
+	a.policy = entity
+
+	return nil
+}
+
+// applyPolicy apply the policy on analysis vulnerabilities and set the policy violations as
+// analysis errors. Vulnerabilities on baseline are considered justified by the baseline reason.
+func (a *Analyzer) applyPolicy() {
+	if a.policy == nil {
+		return
+	}
+
+	for _, violation := range a.policy.Evaluate(a.analysis, a.getJustifiedHashes(), a.fingerprinter) {
+		a.setAnalysisError(violation)
+	}
+}
+
+// getJustifiedHashes return the hashes of the vulnerabilities on baseline and the ones accepted
+// by a risk acceptance of config with justification that is not expired.
+func (a *Analyzer) getJustifiedHashes() []string {
+	hashes := append([]string{}, a.baselineHashes...)
+
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vuln := &a.analysis.AnalysisVulnerabilities[index].Vulnerability
+
+		acceptance := a.config.RiskAcceptances.Find(vuln, a.fingerprinter.Fingerprint(vuln))
+		if acceptance != nil && strings.TrimSpace(acceptance.Justification) != "" &&
+			!acceptance.IsExpired(time.Now()) {
+			hashes = append(hashes, vuln.VulnHash)
 		}
 	}
 
-	return false
+	return hashes
 }
 
 func (a *Analyzer) setFalsePositive() *analysis.Analysis {
-	a.analysis = a.SetFalsePositivesAndRiskAcceptInVulnerabilities(
-		a.config.FalsePositiveHashes, a.config.RiskAcceptHashes)
+	a.baselineHashes = a.getBaselineHashes()
+	riskAccept := append(append([]string{}, a.baselineHashes...), a.config.RiskAcceptHashes...)
+	a.analysis = a.SetFalsePositivesAndRiskAcceptInVulnerabilities(a.config.FalsePositiveHashes, riskAccept)
 
 	a.logWarnIfHashDontExistsInConfig(a.getAllConfigHashes())
 
 	return a.analysis
 }
 
+// getBaselineHashes return the hashes of the vulnerabilities that are on baseline,
+// so they will be set as risk accepted and only new vulnerabilities will be reported.
+func (a *Analyzer) getBaselineHashes() []string {
+	if a.baseline == nil {
+		return nil
+	}
+
+	hashes := a.baseline.Hashes(a.analysis.AnalysisVulnerabilities, a.fingerprinter)
+	logger.LogInfoWithLevel(fmt.Sprintf(messages.MsgInfoVulnerabilitiesOnBaseline, len(hashes)))
+
+	return hashes
+}
+
 func (a *Analyzer) setAnalysisError(err error) {
 	if err != nil {
 		toAppend := ""
@@ -219,28 +380,43 @@ func (a *Analyzer) setAnalysisError(err error) {
 }
 
 // SetFalsePositivesAndRiskAcceptInVulnerabilities set analysis vulnerabilities to false
-// positive or risk accept if the hash exists on falsePositive and riskAccept params.
+// positive or risk accept if the hash or the fingerprint exists on falsePositive and riskAccept
+// params or on the risk acceptances of config. The vulnerabilities suppressed by an inline
+// comment are skipped, so the reason of the suppression is never lost.
 //
 // nolint:lll
 func (a *Analyzer) SetFalsePositivesAndRiskAcceptInVulnerabilities(falsePositive, riskAccept []string) *analysis.Analysis {
 	for idx := range a.analysis.AnalysisVulnerabilities {
-		a.setVulnerabilityType(
-			&a.analysis.AnalysisVulnerabilities[idx].Vulnerability, falsePositive, enumsVulnerability.FalsePositive,
-		)
-		a.setVulnerabilityType(
-			&a.analysis.AnalysisVulnerabilities[idx].Vulnerability, riskAccept, enumsVulnerability.RiskAccepted,
-		)
+		vuln := &a.analysis.AnalysisVulnerabilities[idx].Vulnerability
+		if a.suppressions.Contains(vuln) {
+			continue
+		}
+
+		fingerprint := a.fingerprinter.Fingerprint(vuln)
+
+		a.setVulnerabilityType(vuln, fingerprint, falsePositive, enumsVulnerability.FalsePositive)
+		a.setVulnerabilityType(vuln, fingerprint, riskAccept, enumsVulnerability.RiskAccepted)
+		a.setRiskAcceptance(vuln, fingerprint)
+		a.setBaselineReason(vuln, fingerprint)
 	}
 	return a.analysis
 }
 
+// setVulnerabilityType set the vulnerability type if its hash, one of its deprecated hashes
+// or its fingerprint are on hashes.
+//
 //nolint:gocyclo // complexity will be reduced after removing the deprecated hashes
 func (a *Analyzer) setVulnerabilityType(
-	vuln *vulnerability.Vulnerability, hashes []string, vulnType enumsVulnerability.Type,
+	vuln *vulnerability.Vulnerability, fingerprint string, hashes []string, vulnType enumsVulnerability.Type,
 ) {
 	for _, hash := range hashes {
 		hash = strings.TrimSpace(hash)
 
+		if hash != "" && hash == fingerprint {
+			vuln.Type = vulnType
+			return
+		}
+
 		// See vulnerability.Vulnerability.DeprecatedHashes docs for more info.
 		for _, deprecatedHash := range vuln.DeprecatedHashes {
 			if hash != "" && (strings.TrimSpace(vuln.VulnHash) == hash || strings.TrimSpace(deprecatedHash) == hash) {
@@ -251,6 +427,36 @@ func (a *Analyzer) setVulnerabilityType(
 	}
 }
 
+// setRiskAcceptance set the vulnerability as risk accepted if it has a risk acceptance on config.
+// If the risk acceptance is already expired, it's only warned and the vulnerability keeps the type
+// set by the false positive, risk accept and baseline hashes, since they don't expire.
+func (a *Analyzer) setRiskAcceptance(vuln *vulnerability.Vulnerability, fingerprint string) {
+	acceptance := a.config.RiskAcceptances.Find(vuln, fingerprint)
+	if acceptance == nil {
+		return
+	}
+
+	if acceptance.IsExpired(time.Now()) {
+		a.analysis.AddWarning(fmt.Sprintf(messages.MsgWarnRiskAcceptanceExpired,
+			vuln.VulnHash, acceptance.ExpiresAt, acceptance.Owner, acceptance.Ticket))
+		return
+	}
+
+	vuln.Type = enumsVulnerability.RiskAccepted
+}
+
+// setBaselineReason add the reason of the baseline on details of the risk accepted vulnerabilities
+// that are on it, so the outputs show why they were accepted.
+func (a *Analyzer) setBaselineReason(vuln *vulnerability.Vulnerability, fingerprint string) {
+	if a.baseline == nil || vuln.Type != enumsVulnerability.RiskAccepted {
+		return
+	}
+
+	if accepted := a.baseline.Find(vuln, fingerprint); accepted != nil {
+		vuln.Details = fmt.Sprintf("%s\n%s", vuln.Details, accepted.Details())
+	}
+}
+
 func (a *Analyzer) setAnalysisFinishedData() *analysis.Analysis {
 	a.analysis.FinishedAt = time.Now()
 
@@ -329,8 +535,13 @@ func (a *Analyzer) getVulnerabilitiesBySeverity(
 	return response
 }
 
+// setDefaultVulnerabilityType set all vulnerabilities as Vulnerability type, except the
+// ones suppressed by an inline horusec:ignore comment, that are kept as false positive.
 func (a *Analyzer) setDefaultVulnerabilityType() *analysis.Analysis {
 	for key := range a.analysis.AnalysisVulnerabilities {
+		if a.suppressions.Contains(&a.analysis.AnalysisVulnerabilities[key].Vulnerability) {
+			continue
+		}
 		a.analysis.AnalysisVulnerabilities[key].Vulnerability.Type = enumsVulnerability.Vulnerability
 	}
 	return a.analysis
@@ -368,12 +579,19 @@ func (a *Analyzer) removeInfoVulnerabilities() *analysis.Analysis {
 
 // nolint: funlen,gocyclo
 func (a *Analyzer) removeVulnerabilitiesBySeverity() *analysis.Analysis {
-	var vulnerabilities []analysis.AnalysisVulnerabilities
+	a.analysis.AnalysisVulnerabilities = a.filterIgnoredSeverities(a.analysis.AnalysisVulnerabilities)
+	a.suppressedVulnerabilities = a.filterIgnoredSeverities(a.suppressedVulnerabilities)
+	return a.analysis
+}
+
+func (a *Analyzer) filterIgnoredSeverities(
+	analysisVulnerabilities []analysis.AnalysisVulnerabilities,
+) (vulnerabilities []analysis.AnalysisVulnerabilities) {
 	severitiesToIgnore := a.config.SeveritiesToIgnore
 
 outer:
-	for index := range a.analysis.AnalysisVulnerabilities {
-		vuln := a.analysis.AnalysisVulnerabilities[index]
+	for index := range analysisVulnerabilities {
+		vuln := analysisVulnerabilities[index]
 		for _, severity := range severitiesToIgnore {
 			// Force to print INFO vulnerabilities when information severity is enabled.
 			if severity == severities.Info.ToString() && a.config.EnableInformationSeverity {
@@ -386,20 +604,52 @@ outer:
 		}
 		vulnerabilities = append(vulnerabilities, vuln)
 	}
-	a.analysis.AnalysisVulnerabilities = vulnerabilities
-	return a.analysis
+	return vulnerabilities
 }
 
-func (a *Analyzer) removeVulnerabilitiesByTypes() *analysis.Analysis {
+// removeVulnerabilitiesOutOfChangedLines remove the vulnerabilities that are not on
+// lines changed since the git reference of an incremental analysis. Vulnerabilities
+// without a valid line, like the ones from dependency tools, are kept if their file
+// was changed.
+func (a *Analyzer) removeVulnerabilitiesOutOfChangedLines() {
 	var vulnerabilities []analysis.AnalysisVulnerabilities
 
 	for index := range a.analysis.AnalysisVulnerabilities {
-		vulnType := a.analysis.AnalysisVulnerabilities[index].Vulnerability.Type
-		for _, acceptedType := range a.config.ShowVulnerabilitiesTypes {
-			if strings.EqualFold(vulnType.ToString(), acceptedType) {
+		vuln := a.analysis.AnalysisVulnerabilities[index].Vulnerability
+		file := filepath.Clean(vuln.File)
+
+		line, err := strconv.Atoi(strings.Split(vuln.Line, "-")[0])
+		if err != nil || line <= 0 {
+			if a.changes.ContainsFile(file) {
 				vulnerabilities = append(vulnerabilities, a.analysis.AnalysisVulnerabilities[index])
-				break
 			}
+			continue
+		}
+
+		if a.changes.ContainsLine(file, line) {
+			vulnerabilities = append(vulnerabilities, a.analysis.AnalysisVulnerabilities[index])
+		}
+	}
+
+	a.analysis.AnalysisVulnerabilities = vulnerabilities
+}
+
+// removeVulnerabilitiesByTypes remove the vulnerabilities with types that should not be
+// showed. The vulnerabilities suppressed by an inline comment are moved apart, so they
+// could be audited on the JSON output without being showed by the other outputs.
+func (a *Analyzer) removeVulnerabilitiesByTypes() *analysis.Analysis {
+	var vulnerabilities []analysis.AnalysisVulnerabilities
+
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vuln := a.analysis.AnalysisVulnerabilities[index]
+
+		if a.isShowedVulnerabilityType(vuln.Vulnerability.Type.ToString()) {
+			vulnerabilities = append(vulnerabilities, vuln)
+			continue
+		}
+
+		if a.suppressions.Contains(&vuln.Vulnerability) {
+			a.suppressedVulnerabilities = append(a.suppressedVulnerabilities, vuln)
 		}
 	}
 
@@ -408,6 +658,16 @@ func (a *Analyzer) removeVulnerabilitiesByTypes() *analysis.Analysis {
 	return a.analysis
 }
 
+func (a *Analyzer) isShowedVulnerabilityType(vulnType string) bool {
+	for _, acceptedType := range a.config.ShowVulnerabilitiesTypes {
+		if strings.EqualFold(vulnType, acceptedType) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // setUpdateHashWarnings checks for hashes generated in older formats but that are still valid. If one of
 // these hashes are found, a warning will be showed informing the user to update the outdated hash.
 // TODO: Remove setUpdateHashWarnings before release v2.10.0
@@ -435,11 +695,12 @@ func (a *Analyzer) setUpdateHashWarnings() {
 }
 
 func (a *Analyzer) getAllConfigHashes() []string {
-	size := len(a.config.FalsePositiveHashes) + len(a.config.RiskAcceptHashes)
+	size := len(a.config.FalsePositiveHashes) + len(a.config.RiskAcceptHashes) + len(a.config.RiskAcceptances)
 
 	configHashes := make([]string, size)
 	configHashes = append(configHashes, a.config.FalsePositiveHashes...)
 	configHashes = append(configHashes, a.config.RiskAcceptHashes...)
+	configHashes = append(configHashes, a.config.RiskAcceptances.Hashes()...)
 
 	return configHashes
 }
@@ -481,7 +742,7 @@ func (a *Analyzer) getAllVulnerabilitiesWithDetailsJoined() (
 		exists := false
 		for newIndex := range newAnalysisVulnerabilities {
 			newAV := newAnalysisVulnerabilities[newIndex]
-			if currentAV.Vulnerability.VulnHash == newAV.Vulnerability.VulnHash &&
+			if a.isSameVulnerabilityToJoin(&currentAV.Vulnerability, &newAV.Vulnerability) &&
 				!strings.Contains(newAV.Vulnerability.Details, currentAV.Vulnerability.Details) {
 				exists = true
 				newAnalysisVulnerabilities[newIndex].Vulnerability.Details = fmt.Sprintf("%s\n         %s%s",
@@ -497,6 +758,13 @@ func (a *Analyzer) getAllVulnerabilitiesWithDetailsJoined() (
 	return newAnalysisVulnerabilities
 }
 
+// isSameVulnerabilityToJoin return true if the vulnerabilities could be joined. Only vulnerabilities
+// with the same hash and type are joined, so a vulnerability suppressed by an inline comment is
+// never folded into an active one that found the same code.
+func (a *Analyzer) isSameVulnerabilityToJoin(current, joined *vulnerability.Vulnerability) bool {
+	return current.VulnHash == joined.VulnHash && current.Type == joined.Type
+}
+
 // setCounterOfDetailsDuplicated will check how many details there are by looking
 // for the detailsHeaderText separator constant and will update to add a counter of the details in this vulnerability.
 // nolint:funlen,gocyclo // Breaking this function will make it more confusing
@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
@@ -34,13 +35,19 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/baseline"
+	"github.com/mosajjal/horusec/pkg/entities/policy"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
 	"github.com/mosajjal/horusec/pkg/entities/workdir"
 	"github.com/mosajjal/horusec/pkg/services/docker"
+	"github.com/mosajjal/horusec/pkg/services/git"
+	"github.com/mosajjal/horusec/pkg/services/suppression"
 	"github.com/mosajjal/horusec/pkg/utils/testutil"
 	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
 )
@@ -140,6 +147,127 @@ func TestAnalyzerSetFalsePositivesAndRiskAcceptInVulnerabilities(t *testing.T) {
 	}
 }
 
+func TestAnalyzerSetBaselineReason(t *testing.T) {
+	vuln := vulnerability.Vulnerability{
+		RuleID:  "HS-TEST-1",
+		Line:    "10",
+		File:    "testing",
+		Code:    "testing",
+		Details: "Test",
+	}
+	vulnhash.Bind(&vuln)
+
+	analyzer := New(config.New())
+	analyzer.baseline = baseline.New(&analysis.Analysis{
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{{Vulnerability: vuln}},
+	}, "v2.9.0", "legacy code", analyzer.fingerprinter)
+	analyzer.analysis.AnalysisVulnerabilities = []analysis.AnalysisVulnerabilities{{Vulnerability: vuln}}
+
+	analyzer.SetFalsePositivesAndRiskAcceptInVulnerabilities(nil, []string{vuln.VulnHash})
+
+	accepted := analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability
+	assert.Equal(t, vulnerabilityenum.RiskAccepted, accepted.Type)
+	assert.Equal(t, "Test\nAccepted by baseline: legacy code", accepted.Details)
+}
+
+func TestAnalyzerSetRiskAcceptancesOfConfig(t *testing.T) {
+	vuln := vulnerability.Vulnerability{
+		RuleID: "HS-TEST-1",
+		Line:   "10",
+		File:   "testing",
+		Code:   "testing",
+		Type:   vulnerabilityenum.Vulnerability,
+	}
+	vulnhash.Bind(&vuln)
+
+	newAnalyzer := func(acceptance riskacceptance.RiskAcceptance) *Analyzer {
+		cfg := config.New()
+		cfg.RiskAcceptances = riskacceptance.RiskAcceptances{acceptance}
+
+		analyzer := New(cfg)
+		analyzer.analysis.AnalysisVulnerabilities = []analysis.AnalysisVulnerabilities{{Vulnerability: vuln}}
+
+		return analyzer
+	}
+
+	t.Run("Should set risk accepted by the fingerprint of the vulnerability", func(t *testing.T) {
+		analyzer := newAnalyzer(riskacceptance.RiskAcceptance{Hash: vulnhash.Fingerprint(&vuln, nil)})
+
+		analyzer.SetFalsePositivesAndRiskAcceptInVulnerabilities(nil, nil)
+
+		assert.Equal(t, vulnerabilityenum.RiskAccepted, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+
+	expired := riskacceptance.RiskAcceptance{
+		Hash: vuln.VulnHash, ExpiresAt: "2000-01-01", Owner: "security-team", Ticket: "SEC-1",
+	}
+
+	t.Run("Should keep as vulnerability when risk acceptance is expired", func(t *testing.T) {
+		analyzer := newAnalyzer(expired)
+
+		analyzer.SetFalsePositivesAndRiskAcceptInVulnerabilities(nil, nil)
+
+		assert.Equal(t, vulnerabilityenum.Vulnerability, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+		require.Len(t, analyzer.analysis.Warnings, 1)
+		assert.Contains(t, analyzer.analysis.Warnings[0], "(owner: security-team, ticket: SEC-1)")
+	})
+
+	t.Run("Should keep the type of the hashes when risk acceptance is expired", func(t *testing.T) {
+		analyzer := newAnalyzer(expired)
+
+		analyzer.SetFalsePositivesAndRiskAcceptInVulnerabilities([]string{vuln.VulnHash}, nil)
+		assert.Equal(t, vulnerabilityenum.FalsePositive, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+
+		analyzer = newAnalyzer(expired)
+
+		analyzer.SetFalsePositivesAndRiskAcceptInVulnerabilities(nil, []string{vuln.VulnHash})
+		assert.Equal(t, vulnerabilityenum.RiskAccepted, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+		require.Len(t, analyzer.analysis.Warnings, 1)
+	})
+}
+
+func TestAnalyzerApplyPolicy(t *testing.T) {
+	vuln := vulnerability.Vulnerability{
+		RuleID: "HS-TEST-1",
+		Line:   "10",
+		File:   "testing",
+		Code:   "testing",
+		Type:   vulnerabilityenum.Vulnerability,
+	}
+	vulnhash.Bind(&vuln)
+
+	newAnalyzer := func(acceptance riskacceptance.RiskAcceptance) *Analyzer {
+		cfg := config.New()
+		cfg.RiskAcceptances = riskacceptance.RiskAcceptances{acceptance}
+
+		analyzer := New(cfg)
+		analyzer.policy = &policy.Policy{RequireJustification: true}
+		analyzer.analysis.AnalysisVulnerabilities = []analysis.AnalysisVulnerabilities{{Vulnerability: vuln}}
+
+		return analyzer
+	}
+
+	t.Run("Should accept risk acceptances of config with justification", func(t *testing.T) {
+		analyzer := newAnalyzer(riskacceptance.RiskAcceptance{Hash: vuln.VulnHash, Justification: "not reachable"})
+
+		analyzer.setFalsePositive()
+		analyzer.applyPolicy()
+
+		assert.Empty(t, analyzer.analysis.Errors)
+		assert.Equal(t, vulnerabilityenum.RiskAccepted, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+
+	t.Run("Should return violation for risk acceptances of config without justification", func(t *testing.T) {
+		analyzer := newAnalyzer(riskacceptance.RiskAcceptance{Hash: vuln.VulnHash})
+
+		analyzer.setFalsePositive()
+		analyzer.applyPolicy()
+
+		assert.NotEmpty(t, analyzer.analysis.Errors)
+		assert.Equal(t, vulnerabilityenum.Vulnerability, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+}
+
 func TestNewAnalyzer(t *testing.T) {
 	t.Run("Should return type os struct correctly", func(t *testing.T) {
 		assert.IsType(t, &Analyzer{}, New(&config.Config{}))
@@ -213,6 +341,8 @@ func TestAnalyze(t *testing.T) {
 		printResultMock := testutil.NewPrintResultsMock()
 		printResultMock.On("StartPrintResults").Return(0, nil)
 		printResultMock.On("SetAnalysis")
+		printResultMock.On("SetSuppressedVulnerabilities")
+		printResultMock.On("SetRiskAcceptances")
 
 		horusecAPIMock := testutil.NewHorusecAPIMock()
 		horusecAPIMock.On("SendAnalysis").Return(nil)
@@ -220,11 +350,11 @@ func TestAnalyze(t *testing.T) {
 
 		dockerMocker := testutil.NewDockerClientMock()
 		dockerMocker.On("CreateLanguageAnalysisContainer").Return("", nil)
-		dockerMocker.On("ImageList").Return([]types.ImageSummary{{}}, nil)
+		dockerMocker.On("ImageList").Return([]image.Summary{{}}, nil)
 		dockerMocker.On("ImagePull").Return(io.NopCloser(bytes.NewReader([]byte(""))), nil)
-		dockerMocker.On("ContainerCreate").Return(container.ContainerCreateCreatedBody{}, nil)
+		dockerMocker.On("ContainerCreate").Return(container.CreateResponse{}, nil)
 		dockerMocker.On("ContainerStart").Return(nil)
-		dockerMocker.On("ContainerWait").Return(container.ContainerWaitOKBody{}, nil)
+		dockerMocker.On("ContainerWait").Return(container.WaitResponse{}, nil)
 		dockerMocker.On("ContainerLogs").Return(io.NopCloser(bytes.NewReader([]byte(""))), nil)
 		dockerMocker.On("ContainerRemove").Return(nil)
 		dockerMocker.On("ContainerList").Return([]types.Container{{ID: "test"}}, nil)
@@ -267,6 +397,8 @@ func TestAnalyze(t *testing.T) {
 		printResultMock := testutil.NewPrintResultsMock()
 		printResultMock.On("StartPrintResults").Return(0, nil)
 		printResultMock.On("SetAnalysis")
+		printResultMock.On("SetSuppressedVulnerabilities")
+		printResultMock.On("SetRiskAcceptances")
 
 		horusecAPIMock := testutil.NewHorusecAPIMock()
 		horusecAPIMock.On("SendAnalysis").Return(nil)
@@ -274,11 +406,11 @@ func TestAnalyze(t *testing.T) {
 
 		dockerMocker := testutil.NewDockerClientMock()
 		dockerMocker.On("CreateLanguageAnalysisContainer").Return("", nil)
-		dockerMocker.On("ImageList").Return([]types.ImageSummary{{}}, nil)
+		dockerMocker.On("ImageList").Return([]image.Summary{{}}, nil)
 		dockerMocker.On("ImagePull").Return(io.NopCloser(bytes.NewReader([]byte(""))), nil)
-		dockerMocker.On("ContainerCreate").Return(container.ContainerCreateCreatedBody{}, nil)
+		dockerMocker.On("ContainerCreate").Return(container.CreateResponse{}, nil)
 		dockerMocker.On("ContainerStart").Return(nil)
-		dockerMocker.On("ContainerWait").Return(container.ContainerWaitOKBody{}, nil)
+		dockerMocker.On("ContainerWait").Return(container.WaitResponse{}, nil)
 		dockerMocker.On("ContainerLogs").Return(io.NopCloser(bytes.NewReader([]byte(""))), nil)
 		dockerMocker.On("ContainerRemove").Return(nil)
 		dockerMocker.On("ContainerList").Return([]types.Container{{ID: "test"}}, nil)
@@ -306,6 +438,8 @@ func TestAnalyze(t *testing.T) {
 		printResultMock := testutil.NewPrintResultsMock()
 		printResultMock.On("StartPrintResults").Return(0, nil)
 		printResultMock.On("SetAnalysis")
+		printResultMock.On("SetSuppressedVulnerabilities")
+		printResultMock.On("SetRiskAcceptances")
 
 		horusecAPIMock := testutil.NewHorusecAPIMock()
 		horusecAPIMock.On("SendAnalysis").Return(nil)
@@ -313,11 +447,11 @@ func TestAnalyze(t *testing.T) {
 
 		dockerMocker := testutil.NewDockerClientMock()
 		dockerMocker.On("CreateLanguageAnalysisContainer").Return("", nil)
-		dockerMocker.On("ImageList").Return([]types.ImageSummary{{}}, nil)
+		dockerMocker.On("ImageList").Return([]image.Summary{{}}, nil)
 		dockerMocker.On("ImagePull").Return(io.NopCloser(bytes.NewReader([]byte(""))), nil)
-		dockerMocker.On("ContainerCreate").Return(container.ContainerCreateCreatedBody{}, nil)
+		dockerMocker.On("ContainerCreate").Return(container.CreateResponse{}, nil)
 		dockerMocker.On("ContainerStart").Return(nil)
-		dockerMocker.On("ContainerWait").Return(container.ContainerWaitOKBody{}, nil)
+		dockerMocker.On("ContainerWait").Return(container.WaitResponse{}, nil)
 		dockerMocker.On("ContainerLogs").Return(io.NopCloser(bytes.NewReader([]byte(""))), nil)
 		dockerMocker.On("ContainerRemove").Return(nil)
 		dockerMocker.On("ContainerList").Return([]types.Container{{ID: "test"}}, nil)
@@ -359,6 +493,8 @@ func TestAnalyze(t *testing.T) {
 		pr := testutil.NewPrintResultsMock()
 		pr.On("StartPrintResults").Return(0, nil)
 		pr.On("SetAnalysis")
+		pr.On("SetSuppressedVulnerabilities")
+		pr.On("SetRiskAcceptances")
 
 		analyzer := &Analyzer{
 			config:          cfg,
@@ -375,3 +511,239 @@ func TestAnalyze(t *testing.T) {
 		assert.Len(t, analysiss.AnalysisVulnerabilities, 1, "Expected that analysis contains info vulnerabilities")
 	})
 }
+
+func TestRemoveVulnerabilitiesOutOfChangedLines(t *testing.T) {
+	t.Run("Should keep only the vulnerabilities on the changed lines and files", func(t *testing.T) {
+		analyzer := &Analyzer{
+			config: config.New(),
+			changes: git.Changes{
+				"main.go": []git.LineRange{{Start: 10, End: 12}},
+				"go.sum":  []git.LineRange{{Start: 1, End: 1}},
+				"new.go":  nil,
+			},
+			analysis: &analysis.Analysis{
+				AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+					{Vulnerability: vulnerability.Vulnerability{File: "main.go", Line: "11"}},
+					{Vulnerability: vulnerability.Vulnerability{File: "main.go", Line: "20"}},
+					{Vulnerability: vulnerability.Vulnerability{File: "go.sum", Line: "-"}},
+					{Vulnerability: vulnerability.Vulnerability{File: "new.go", Line: "100"}},
+					{Vulnerability: vulnerability.Vulnerability{File: "unchanged.go", Line: "1"}},
+				},
+			},
+		}
+
+		analyzer.removeVulnerabilitiesOutOfChangedLines()
+
+		files := make([]string, 0, len(analyzer.analysis.AnalysisVulnerabilities))
+		for _, vuln := range analyzer.analysis.AnalysisVulnerabilities {
+			files = append(files, vuln.Vulnerability.File+":"+vuln.Vulnerability.Line)
+		}
+
+		assert.Equal(t, []string{"main.go:11", "go.sum:-", "new.go:100"}, files)
+	})
+}
+
+func TestJoinAllVulnerabilitiesOfSameToolAndHash(t *testing.T) {
+	t.Run("should not join a suppressed vulnerability with another rule on the same line", func(t *testing.T) {
+		suppressedDetails := "Unsafe usage\n" + suppression.DetailsPrefix
+		analyzer := &Analyzer{
+			config: config.New(),
+			analysis: &analysis.Analysis{
+				AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							RuleID:   "HS-GO-6",
+							Type:     vulnerabilityenum.FalsePositive,
+							Details:  suppressedDetails,
+							VulnHash: "same-hash",
+						},
+					},
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							RuleID:   "HS-LEAKS-26",
+							Type:     vulnerabilityenum.Vulnerability,
+							Details:  "Hard-coded password",
+							VulnHash: "same-hash",
+						},
+					},
+				},
+			},
+		}
+
+		analyzer.joinAllVulnerabilitiesOfSameToolAndHash()
+
+		require.Len(t, analyzer.analysis.AnalysisVulnerabilities, 2)
+		assert.Contains(t, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Details, suppressedDetails)
+		assert.NotContains(t, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Details, "Hard-coded password")
+		assert.Contains(t, analyzer.analysis.AnalysisVulnerabilities[1].Vulnerability.Details, "Hard-coded password")
+		assert.NotContains(t, analyzer.analysis.AnalysisVulnerabilities[1].Vulnerability.Details, suppression.DetailsPrefix)
+	})
+
+	t.Run("should join vulnerabilities of different rules with same hash and type", func(t *testing.T) {
+		analyzer := &Analyzer{
+			config: config.New(),
+			analysis: &analysis.Analysis{
+				AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							RuleID:   "HS-GO-6",
+							Type:     vulnerabilityenum.Vulnerability,
+							Details:  "Unsafe usage",
+							VulnHash: "same-hash",
+						},
+					},
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							RuleID:   "HS-LEAKS-26",
+							Type:     vulnerabilityenum.Vulnerability,
+							Details:  "Hard-coded password",
+							VulnHash: "same-hash",
+						},
+					},
+				},
+			},
+		}
+
+		analyzer.joinAllVulnerabilitiesOfSameToolAndHash()
+
+		require.Len(t, analyzer.analysis.AnalysisVulnerabilities, 1)
+		details := analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability.Details
+		assert.Contains(t, details, "(1/2) "+detailsHeaderText+"Unsafe usage")
+		assert.Contains(t, details, "(2/2) "+detailsHeaderText+"Hard-coded password")
+	})
+}
+
+func TestSuppressVulnerabilities(t *testing.T) {
+	cfg := config.New()
+	cfg.ProjectPath = t.TempDir()
+
+	src := "package main\n" +
+		"// horusec:ignore HS-GO-1 reason=\"test only\"\n" +
+		"password := \"secret\"\n" +
+		"token := \"secret\" // horusec:ignore\n" +
+		"key := \"secret\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.ProjectPath, "main.go"), []byte(src), 0o600))
+
+	analyzer := &Analyzer{
+		config: cfg,
+		analysis: &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerability.Vulnerability{RuleID: "HS-GO-1", File: "main.go", Line: "3", VulnHash: "3"}},
+				{Vulnerability: vulnerability.Vulnerability{RuleID: "HS-GO-2", File: "main.go", Line: "3", VulnHash: "3"}},
+				{Vulnerability: vulnerability.Vulnerability{RuleID: "G101", File: "main.go", Line: "4", VulnHash: "4"}},
+				{Vulnerability: vulnerability.Vulnerability{RuleID: "G101", File: "main.go", Line: "5", VulnHash: "5"}},
+			},
+		},
+	}
+
+	analyzer.suppressVulnerabilities()
+
+	vulnerabilities := analyzer.analysis.AnalysisVulnerabilities
+	assert.Equal(t, vulnerabilityenum.FalsePositive, vulnerabilities[0].Vulnerability.Type)
+	assert.Contains(t, vulnerabilities[0].Vulnerability.Details, "test only")
+	assert.True(t, analyzer.suppressions.Contains(&vulnerabilities[0].Vulnerability))
+
+	assert.Empty(t, vulnerabilities[1].Vulnerability.Type)
+	assert.False(t, analyzer.suppressions.Contains(&vulnerabilities[1].Vulnerability),
+		"another rule with the same hash should not be suppressed")
+
+	assert.Equal(t, vulnerabilityenum.FalsePositive, vulnerabilities[2].Vulnerability.Type)
+	assert.True(t, analyzer.suppressions.Contains(&vulnerabilities[2].Vulnerability))
+
+	assert.Empty(t, vulnerabilities[3].Vulnerability.Type, "trailing comment should not suppress the next line")
+}
+
+func TestSetFalsePositivesAndRiskAcceptOfSuppressedVulnerabilities(t *testing.T) {
+	suppressed := vulnerability.Vulnerability{
+		RuleID:   "HS-GO-6",
+		Type:     vulnerabilityenum.FalsePositive,
+		Details:  suppression.DetailsPrefix + ": test fixture",
+		VulnHash: "same-hash",
+	}
+	vuln := vulnerability.Vulnerability{
+		RuleID:   "HS-LEAKS-26",
+		Type:     vulnerabilityenum.Vulnerability,
+		VulnHash: "same-hash",
+	}
+
+	cfg := config.New()
+	cfg.RiskAcceptances = riskacceptance.RiskAcceptances{{Hash: "same-hash"}}
+
+	analyzer := New(cfg)
+	analyzer.suppressions = suppression.Set{}
+	analyzer.suppressions.Add(&suppressed)
+	analyzer.analysis.AnalysisVulnerabilities = []analysis.AnalysisVulnerabilities{
+		{Vulnerability: suppressed},
+		{Vulnerability: vuln},
+	}
+
+	analyzer.SetFalsePositivesAndRiskAcceptInVulnerabilities(nil, []string{"same-hash"})
+
+	assert.Equal(t, suppressed, analyzer.analysis.AnalysisVulnerabilities[0].Vulnerability,
+		"suppressed vulnerability should not be changed by risk accept hashes")
+	assert.Equal(t, vulnerabilityenum.RiskAccepted, analyzer.analysis.AnalysisVulnerabilities[1].Vulnerability.Type)
+}
+
+func TestFormatAnalysisToPrintWithSuppressedVulnerabilities(t *testing.T) {
+	t.Run("should move suppressed vulnerabilities out of the analysis", func(t *testing.T) {
+		suppressed := analysis.AnalysisVulnerabilities{
+			Vulnerability: vulnerability.Vulnerability{
+				RuleID:   "HS-GO-6",
+				Type:     vulnerabilityenum.FalsePositive,
+				Severity: severities.High,
+				Details:  suppression.DetailsPrefix,
+			},
+		}
+		falsePositive := analysis.AnalysisVulnerabilities{
+			Vulnerability: vulnerability.Vulnerability{
+				RuleID:   "HS-GO-7",
+				Type:     vulnerabilityenum.FalsePositive,
+				Severity: severities.High,
+				Details:  "Details that quotes " + suppression.DetailsPrefix,
+			},
+		}
+		vuln := analysis.AnalysisVulnerabilities{
+			Vulnerability: vulnerability.Vulnerability{
+				RuleID:   "HS-LEAKS-26",
+				Type:     vulnerabilityenum.Vulnerability,
+				Severity: severities.High,
+			},
+		}
+		analyzer := &Analyzer{
+			config:       config.New(),
+			suppressions: suppression.Set{},
+			analysis: &analysis.Analysis{
+				AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{suppressed, falsePositive, vuln},
+			},
+		}
+		analyzer.suppressions.Add(&suppressed.Vulnerability)
+
+		analyzer.formatAnalysisToPrint()
+
+		assert.Equal(t, []analysis.AnalysisVulnerabilities{vuln}, analyzer.analysis.AnalysisVulnerabilities)
+		assert.Equal(t, []analysis.AnalysisVulnerabilities{suppressed}, analyzer.suppressedVulnerabilities)
+	})
+}
+
+func TestGetAnalysisHashes(t *testing.T) {
+	vuln := vulnerability.Vulnerability{
+		RuleID:           "HS-GO-1",
+		File:             "main.go",
+		Code:             "password := \"secret\"",
+		VulnHash:         "hash",
+		DeprecatedHashes: []string{"deprecated-hash"},
+	}
+	analyzer := &Analyzer{
+		analysis: &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{{Vulnerability: vuln}},
+		},
+	}
+
+	hashes := analyzer.getAnalysisHashes()
+
+	assert.Equal(t, map[string]bool{
+		"hash":                           true,
+		"deprecated-hash":                true,
+		vulnhash.Fingerprint(&vuln, nil): true,
+	}, hashes)
+}
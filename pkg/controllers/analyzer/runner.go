@@ -34,20 +34,25 @@ import (
 	"github.com/mosajjal/horusec/pkg/enums/images"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
 	"github.com/mosajjal/horusec/pkg/services/docker"
+	"github.com/mosajjal/horusec/pkg/services/engines/dockerfile"
 	"github.com/mosajjal/horusec/pkg/services/formatters"
 	"github.com/mosajjal/horusec/pkg/services/formatters/c/flawfinder"
+	"github.com/mosajjal/horusec/pkg/services/formatters/c/horusecc"
 	dotnetcli "github.com/mosajjal/horusec/pkg/services/formatters/csharp/dotnet_cli"
 	"github.com/mosajjal/horusec/pkg/services/formatters/csharp/horuseccsharp"
 	"github.com/mosajjal/horusec/pkg/services/formatters/csharp/scs"
 	"github.com/mosajjal/horusec/pkg/services/formatters/dart/horusecdart"
+	"github.com/mosajjal/horusec/pkg/services/formatters/dockerfile/horusecdockerfile"
 	"github.com/mosajjal/horusec/pkg/services/formatters/elixir/mixaudit"
 	"github.com/mosajjal/horusec/pkg/services/formatters/elixir/sobelow"
 	dependencycheck "github.com/mosajjal/horusec/pkg/services/formatters/generic/dependency_check"
 	"github.com/mosajjal/horusec/pkg/services/formatters/generic/semgrep"
 	"github.com/mosajjal/horusec/pkg/services/formatters/generic/trivy"
 	"github.com/mosajjal/horusec/pkg/services/formatters/go/gosec"
+	"github.com/mosajjal/horusec/pkg/services/formatters/go/horusecgo"
 	"github.com/mosajjal/horusec/pkg/services/formatters/go/nancy"
 	"github.com/mosajjal/horusec/pkg/services/formatters/hcl/checkov"
+	"github.com/mosajjal/horusec/pkg/services/formatters/hcl/horusechcl"
 	"github.com/mosajjal/horusec/pkg/services/formatters/hcl/tfsec"
 	"github.com/mosajjal/horusec/pkg/services/formatters/java/horusecjava"
 	"github.com/mosajjal/horusec/pkg/services/formatters/javascript/horusecjavascript"
@@ -57,13 +62,18 @@ import (
 	"github.com/mosajjal/horusec/pkg/services/formatters/leaks/gitleaks"
 	"github.com/mosajjal/horusec/pkg/services/formatters/leaks/horusecleaks"
 	"github.com/mosajjal/horusec/pkg/services/formatters/nginx/horusecnginx"
+	"github.com/mosajjal/horusec/pkg/services/formatters/php/horusecphp"
 	"github.com/mosajjal/horusec/pkg/services/formatters/php/phpcs"
 	"github.com/mosajjal/horusec/pkg/services/formatters/python/bandit"
+	"github.com/mosajjal/horusec/pkg/services/formatters/python/horusecpython"
 	"github.com/mosajjal/horusec/pkg/services/formatters/python/safety"
 	"github.com/mosajjal/horusec/pkg/services/formatters/ruby/brakeman"
 	"github.com/mosajjal/horusec/pkg/services/formatters/ruby/bundler"
+	"github.com/mosajjal/horusec/pkg/services/formatters/ruby/horusecruby"
+	"github.com/mosajjal/horusec/pkg/services/formatters/shell/horusecshell"
 	"github.com/mosajjal/horusec/pkg/services/formatters/shell/shellcheck"
 	"github.com/mosajjal/horusec/pkg/services/formatters/swift/horusecswift"
+	"github.com/mosajjal/horusec/pkg/services/formatters/yaml/horuseccicd"
 	"github.com/mosajjal/horusec/pkg/services/formatters/yaml/horuseckubernetes"
 )
 
@@ -180,6 +190,7 @@ func (r *runner) detectVulnerabilityFuncs() map[languages.Language]detectVulnera
 		languages.Shell:      r.detectVulnerabilityShell,
 		languages.Nginx:      r.detectVulnerabilityNginx,
 		languages.Swift:      r.detectVulneravilitySwift,
+		dockerfile.Language:  r.detectVulnerabilityDockerfile,
 	}
 }
 
@@ -214,6 +225,8 @@ func (r *runner) detectVulnerabilityLeaks(wg *sync.WaitGroup, projectSubPath str
 }
 
 func (r *runner) detectVulnerabilityGo(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusecgo.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.Go)); err != nil {
 		return err
 	}
@@ -250,6 +263,8 @@ func (r *runner) detectVulnerabilityJavascript(wg *sync.WaitGroup, projectSubPat
 }
 
 func (r *runner) detectVulnerabilityPython(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusecpython.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.Python)); err != nil {
 		return err
 	}
@@ -259,6 +274,8 @@ func (r *runner) detectVulnerabilityPython(wg *sync.WaitGroup, projectSubPath st
 }
 
 func (r *runner) detectVulnerabilityRuby(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusecruby.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.Ruby)); err != nil {
 		return err
 	}
@@ -268,6 +285,8 @@ func (r *runner) detectVulnerabilityRuby(wg *sync.WaitGroup, projectSubPath stri
 }
 
 func (r *runner) detectVulnerabilityHCL(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusechcl.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.HCL)); err != nil {
 		return err
 	}
@@ -276,12 +295,20 @@ func (r *runner) detectVulnerabilityHCL(wg *sync.WaitGroup, projectSubPath strin
 	return nil
 }
 
-func (r *runner) detectVulnerabilityYaml(_ *sync.WaitGroup, projectSubPath string) error {
+func (r *runner) detectVulnerabilityDockerfile(_ *sync.WaitGroup, projectSubPath string) error {
+	horusecdockerfile.NewFormatter(r.formatter).StartAnalysis(projectSubPath)
+	return nil
+}
+
+func (r *runner) detectVulnerabilityYaml(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horuseccicd.NewFormatter(r.formatter), projectSubPath)
 	horuseckubernetes.NewFormatter(r.formatter).StartAnalysis(projectSubPath)
 	return nil
 }
 
-func (r *runner) detectVulnerabilityC(_ *sync.WaitGroup, projectSubPath string) error {
+func (r *runner) detectVulnerabilityC(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusecc.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.C)); err != nil {
 		return err
 	}
@@ -289,7 +316,9 @@ func (r *runner) detectVulnerabilityC(_ *sync.WaitGroup, projectSubPath string)
 	return nil
 }
 
-func (r *runner) detectVulnerabilityPHP(_ *sync.WaitGroup, projectSubPath string) error {
+func (r *runner) detectVulnerabilityPHP(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusecphp.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.PHP)); err != nil {
 		return err
 	}
@@ -322,7 +351,9 @@ func (r *runner) detectVulnerabilityElixir(wg *sync.WaitGroup, projectSubPath st
 	return nil
 }
 
-func (r *runner) detectVulnerabilityShell(_ *sync.WaitGroup, projectSubPath string) error {
+func (r *runner) detectVulnerabilityShell(wg *sync.WaitGroup, projectSubPath string) error {
+	spawn(wg, horusecshell.NewFormatter(r.formatter), projectSubPath)
+
 	if err := r.docker.PullImage(r.getCustomOrDefaultImage(languages.Shell)); err != nil {
 		return err
 	}
@@ -30,6 +30,8 @@ import (
 	"github.com/mosajjal/horusec/config"
 	"github.com/mosajjal/horusec/pkg/enums/toignore"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/engines/dockerfile"
+	"github.com/mosajjal/horusec/pkg/services/git"
 	"github.com/mosajjal/horusec/pkg/utils/copy"
 )
 
@@ -40,6 +42,7 @@ const prefixGitSubModule = "gitdir: "
 type LanguageDetect struct {
 	config     *config.Config
 	analysisID uuid.UUID
+	changes    git.Changes
 }
 
 // NewLanguageDetect create a new language detect.
@@ -50,6 +53,11 @@ func NewLanguageDetect(cfg *config.Config, analysisID uuid.UUID) *LanguageDetect
 	}
 }
 
+// SetChanges implements analyzer.LanguageDetect.SetChanges.
+func (ld *LanguageDetect) SetChanges(changes git.Changes) {
+	ld.changes = changes
+}
+
 // Detect implements analyzer.LanguageDetect.Detect.
 //
 // nolint: funlen
@@ -125,11 +133,29 @@ func (ld *LanguageDetect) detectLanguages(path string, info os.FileInfo) ([]stri
 		return nil, false
 	}
 
-	langs := enry.GetLanguages(path, nil)
+	langs := ld.setDockerfileLanguage(path, enry.GetLanguages(path, nil))
 	logger.LogTraceWithLevel(messages.MsgTraceLanguageFound, map[string][]string{path: langs})
 	return langs, false
 }
 
+// setDockerfileLanguage set the Dockerfile language only to the files analyzed by the
+// Dockerfile engine, since they are identified by their names, e.g. Dockerfile.prod.
+func (ld *LanguageDetect) setDockerfileLanguage(path string, langs []string) []string {
+	output := make([]string, 0, len(langs)+1)
+
+	for _, lang := range langs {
+		if !strings.EqualFold(lang, dockerfile.Language.ToString()) {
+			output = append(output, lang)
+		}
+	}
+
+	if dockerfile.IsDockerfile(path) {
+		output = append(output, dockerfile.Language.ToString())
+	}
+
+	return output
+}
+
 func (ld *LanguageDetect) uniqueLanguages(langs []languages.Language) (output []languages.Language) {
 	for _, language := range langs {
 		if len(output) == 0 {
@@ -158,7 +184,8 @@ func (ld *LanguageDetect) appendIfLanguageNotExists(
 }
 
 func (ld *LanguageDetect) isPathToIgnore(path string) bool {
-	return ld.checkDefaultPathsToIgnore(path) || ld.checkAdditionalPathsToIgnore(path) || ld.checkExtensionToIgnore(path)
+	return ld.checkDefaultPathsToIgnore(path) || ld.checkAdditionalPathsToIgnore(path) ||
+		ld.checkExtensionToIgnore(path) || ld.checkUnchangedPathToIgnore(path)
 }
 
 func (ld *LanguageDetect) checkDefaultPathsToIgnore(path string) bool {
@@ -193,6 +220,26 @@ func (ld *LanguageDetect) checkExtensionToIgnore(path string) bool {
 	return false
 }
 
+// checkUnchangedPathToIgnore return true if the analysis is incremental and the path
+// is not a changed file or a directory that contains changed files. The .git folder
+// is never ignored here, since it is handled by checkDefaultPathsToIgnore.
+func (ld *LanguageDetect) checkUnchangedPathToIgnore(path string) bool {
+	if ld.changes == nil {
+		return false
+	}
+
+	relative, err := filepath.Rel(ld.config.ProjectPath, path)
+	if err != nil {
+		return false
+	}
+
+	if relative == ".git" || strings.HasPrefix(relative, ".git"+string(os.PathSeparator)) {
+		return false
+	}
+
+	return !ld.changes.ContainsPath(relative)
+}
+
 func (ld *LanguageDetect) copyProjectToHorusecFolder(directory string) error {
 	folderDstName := filepath.Join(directory, ".horusec", ld.analysisID.String())
 	if err := copy.Copy(directory, folderDstName, ld.isPathToIgnore); err != nil {
@@ -217,6 +264,8 @@ func (ld *LanguageDetect) parseLanguage(lang string) languages.Language {
 		return languages.C
 	case ld.isBatFileOrShellFile(lang):
 		return languages.Shell
+	case strings.EqualFold(lang, dockerfile.Language.ToString()):
+		return dockerfile.Language
 	default:
 		return languages.ParseStringToLanguage(lang)
 	}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -32,6 +33,8 @@ import (
 	"github.com/mosajjal/horusec/config"
 	"github.com/mosajjal/horusec/pkg/enums/toignore"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/engines/dockerfile"
+	"github.com/mosajjal/horusec/pkg/services/git"
 	"github.com/mosajjal/horusec/pkg/utils/copy"
 	"github.com/mosajjal/horusec/pkg/utils/testutil"
 )
@@ -349,6 +352,55 @@ func TestLanguageDetect(t *testing.T) {
 		// TODO: We don't have examples of TypeScript language and Unknown language on examples folder, this will fail when we add them
 		assert.Len(t, langs, len(languages.Values())-2)
 	})
+	t.Run("Should run language detect and return DOCKERFILE and GITLEAKS", func(t *testing.T) {
+		srcPath := filepath.Join(tmpPath, uuid.New().String())
+		assert.NoError(t, os.MkdirAll(srcPath, 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(srcPath, "Dockerfile.prod"), []byte("FROM alpine:3.18\n"), 0o600))
+
+		controller := NewLanguageDetect(config.New(), uuid.New())
+
+		langs, err := controller.Detect(srcPath)
+
+		assert.NoError(t, err)
+		assert.Contains(t, langs, languages.Leaks)
+		assert.Contains(t, langs, languages.Generic)
+		assert.Contains(t, langs, dockerfile.Language)
+		assert.Len(t, langs, 3)
+	})
+	t.Run("Should run language detect only on changed files when analysis is incremental", func(t *testing.T) {
+		srcPath := filepath.Join(tmpPath, uuid.New().String())
+		assert.NoError(t, os.MkdirAll(srcPath, 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(srcPath, "main.go"), []byte("package main\n"), 0o600))
+
+		for _, args := range [][]string{
+			{"init", "--quiet"},
+			{"add", "--all"},
+			{"-c", "user.name=horusec", "-c", "user.email=horusec@zup.com.br", "commit", "--quiet", "-m", "init"},
+		} {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = srcPath
+			output, err := cmd.CombinedOutput()
+			assert.NoError(t, err, string(output))
+		}
+
+		assert.NoError(t, os.WriteFile(filepath.Join(srcPath, "Dockerfile"), []byte("FROM alpine:3.18\n"), 0o600))
+
+		cfg := config.New()
+		cfg.ProjectPath = srcPath
+		cfg.ChangedFiles = true
+
+		changes, err := git.New(cfg).Changes()
+		assert.NoError(t, err)
+
+		controller := NewLanguageDetect(cfg, uuid.New())
+		controller.SetChanges(changes)
+
+		langs, err := controller.Detect(srcPath)
+
+		assert.NoError(t, err)
+		assert.Contains(t, langs, dockerfile.Language)
+		assert.NotContains(t, langs, languages.Go)
+	})
 	t.Run("Should ignore folders present in toignore.GetDefaultFoldersToIgnore()", func(t *testing.T) {
 		wd, err := os.Getwd()
 		assert.NoError(t, err)
@@ -15,13 +15,16 @@
 package printresults
 
 import (
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
@@ -31,14 +34,24 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
 	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
 	"github.com/mosajjal/horusec/pkg/enums/outputtype"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/codeclimate"
+	"github.com/mosajjal/horusec/pkg/services/gitlab"
+	"github.com/mosajjal/horusec/pkg/services/html"
+	"github.com/mosajjal/horusec/pkg/services/junit"
 	"github.com/mosajjal/horusec/pkg/services/sarif"
 	"github.com/mosajjal/horusec/pkg/services/sonarqube"
 	"github.com/mosajjal/horusec/pkg/utils/file"
+	"github.com/mosajjal/horusec/pkg/utils/severity"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
 )
 
-var ErrOutputJSON = errors.New("{HORUSEC_CLI} error creating and/or writing to the specified file")
+var (
+	ErrOutputJSON         = errors.New("{HORUSEC_CLI} error creating and/or writing to the specified file")
+	ErrOutputTypeNotValid = errors.New("{HORUSEC_CLI} output type not valid")
+)
 
 type SarifConverter interface {
 	ConvertVulnerabilityToSarif() sarif.Report
@@ -51,6 +64,18 @@ type SonarQubeConverter interface {
 type analysisOutputJSON struct {
 	Version string `json:"version"`
 	analysis.Analysis
+	AnalysisVulnerabilities []analysisVulnerabilityOutputJSON `json:"analysisVulnerabilities"`
+}
+
+// analysisVulnerabilityOutputJSON add the fingerprint to the vulnerabilities of the JSON output.
+type analysisVulnerabilityOutputJSON struct {
+	analysis.AnalysisVulnerabilities
+	Vulnerability vulnerabilityOutputJSON `json:"vulnerabilities"`
+}
+
+type vulnerabilityOutputJSON struct {
+	vulnerability.Vulnerability
+	Fingerprint string `json:"fingerprint"`
 }
 
 // PrintResults is reponsable to print results of an analysis
@@ -63,6 +88,10 @@ type PrintResults struct {
 	sonarqubeService SonarQubeConverter
 	textOutput       string
 	writer           io.Writer
+	fingerprinter    *vulnhash.Fingerprinter
+
+	suppressedVulnerabilities []analysis.AnalysisVulnerabilities
+	riskAcceptances           riskacceptance.RiskAcceptances
 }
 
 // NewPrintResults create a new PrintResults using os.Stdout as writer.
@@ -70,11 +99,12 @@ func NewPrintResults(entity *analysis.Analysis, cfg *config.Config) *PrintResult
 	return &PrintResults{
 		analysis:         entity,
 		config:           cfg,
-		sarifService:     sarif.NewSarif(entity),
+		sarifService:     sarif.NewSarif(entity, cfg.ProjectPath),
 		sonarqubeService: sonarqube.NewSonarQube(entity),
 		writer:           os.Stdout,
 		totalVulns:       0,
 		textOutput:       "",
+		fingerprinter:    vulnhash.NewFingerprinter(cfg.ProjectPath),
 	}
 }
 
@@ -82,6 +112,18 @@ func (pr *PrintResults) SetAnalysis(entity *analysis.Analysis) {
 	pr.analysis = entity
 }
 
+// SetSuppressedVulnerabilities set the vulnerabilities suppressed by an inline comment.
+// They are only written on the JSON output, so the suppressions could be audited.
+func (pr *PrintResults) SetSuppressedVulnerabilities(vulnerabilities []analysis.AnalysisVulnerabilities) {
+	pr.suppressedVulnerabilities = vulnerabilities
+}
+
+// SetRiskAcceptances set the risk acceptances of the policy file. They are warned when
+// expiring soon together with the risk acceptances of config.
+func (pr *PrintResults) SetRiskAcceptances(acceptances riskacceptance.RiskAcceptances) {
+	pr.riskAcceptances = acceptances
+}
+
 func (pr *PrintResults) Print() (totalVulns int, err error) {
 	if err := pr.printByOutputType(); err != nil {
 		return 0, err
@@ -100,19 +142,80 @@ func (pr *PrintResults) Print() (totalVulns int, err error) {
 }
 
 func (pr *PrintResults) printByOutputType() error {
-	switch {
-	case pr.config.PrintOutputType == outputtype.JSON:
-		return pr.printResultsJSON()
-	case pr.config.PrintOutputType == outputtype.Sarif:
-		return pr.printResultsSarif()
-	case pr.config.PrintOutputType == outputtype.SonarQube:
-		return pr.printResultsSonarQube()
+	if len(pr.config.Outputs) > 0 {
+		return pr.printByOutputs()
+	}
+
+	return pr.printByType(pr.config.PrintOutputType, pr.config.JSONOutputFilePath)
+}
+
+// printByOutputs print the analysis on all outputs of config, in the format "type=path". The
+// text summary is always printed on stdout after the other outputs, and it's also written on
+// a file when the text output sets one.
+func (pr *PrintResults) printByOutputs() error {
+	textOutputPath := ""
+
+	for _, output := range pr.outputs() {
+		outputType, path := outputtype.Parse(output)
+		if outputType == outputtype.Text {
+			textOutputPath = path
+			continue
+		}
+
+		if err := pr.printByType(outputType, path); err != nil {
+			return err
+		}
+	}
+
+	return pr.printResultsText(textOutputPath)
+}
+
+// outputs return the outputs of config, merging the output format and output file path into
+// them when they're also set, so the output format is never silently ignored.
+func (pr *PrintResults) outputs() []string {
+	if pr.config.PrintOutputType == "" {
+		return pr.config.Outputs
+	}
+
+	output := pr.config.PrintOutputType
+	if pr.config.JSONOutputFilePath != "" {
+		output += "=" + pr.config.JSONOutputFilePath
+	}
+
+	logger.LogWarnWithLevel(fmt.Sprintf(messages.MsgWarnOutputFormatMergedIntoOutputs,
+		pr.config.PrintOutputType, output))
+
+	return append(append(make([]string, 0, len(pr.config.Outputs)+1), pr.config.Outputs...), output)
+}
+
+// printByType print the analysis on the format of outputType, writing it on path.
+// The default empty output type is printed as text only on stdout.
+func (pr *PrintResults) printByType(outputType, path string) error {
+	switch outputType {
+	case outputtype.JSON:
+		return pr.printResultsJSON(path)
+	case outputtype.Sarif:
+		return pr.printResultsSarif(path)
+	case outputtype.SonarQube:
+		return pr.printResultsSonarQube(path)
+	case outputtype.HTML:
+		return pr.printResultsHTML(path)
+	case outputtype.JUnit:
+		return pr.printResultsJUnit(path)
+	case outputtype.GitLabSAST:
+		return pr.printResultsGitLabSAST(path)
+	case outputtype.GitLabDependencyScanning:
+		return pr.printResultsGitLabDependencyScanning(path)
+	case outputtype.CodeClimate:
+		return pr.printResultsCodeClimate(path)
+	case "", outputtype.Text:
+		return pr.printResultsText(path)
 	default:
-		return pr.printResultsText()
+		return fmt.Errorf("%w: %s", ErrOutputTypeNotValid, outputType)
 	}
 }
 
-func (pr *PrintResults) printResultsText() error {
+func (pr *PrintResults) printResultsText(path string) error {
 	fmt.Fprint(pr.writer, "\n")
 	pr.logSeparator(true)
 
@@ -126,14 +229,30 @@ func (pr *PrintResults) printResultsText() error {
 	pr.logSeparator(true)
 
 	pr.printTextOutputVulnerability()
+	pr.printRiskAcceptancesExpiringSoon()
 
-	return pr.createTxtOutputFile()
+	return pr.createTxtOutputFile(path)
 }
 
-func (pr *PrintResults) printResultsJSON() error {
+// printRiskAcceptancesExpiringSoon warns about the risk acceptances of config and policy that
+// will expire within the configured warning days.
+func (pr *PrintResults) printRiskAcceptancesExpiringSoon() {
+	if pr.config.RiskAcceptanceWarningDays <= 0 {
+		return
+	}
+
+	acceptances := append(append(riskacceptance.RiskAcceptances{}, pr.config.RiskAcceptances...), pr.riskAcceptances...)
+	for _, acceptance := range acceptances.ExpiringWithin(time.Now(), pr.config.RiskAcceptanceWarningDays) {
+		logger.LogWarnWithLevel(fmt.Sprintf(messages.MsgWarnRiskAcceptanceExpiringSoon,
+			acceptance.Hash, acceptance.ExpiresAt, acceptance.Owner, acceptance.Ticket))
+	}
+}
+
+func (pr *PrintResults) printResultsJSON(path string) error {
 	a := analysisOutputJSON{
-		Analysis: *pr.analysis,
-		Version:  pr.config.Version,
+		Analysis:                *pr.analysis,
+		Version:                 pr.config.Version,
+		AnalysisVulnerabilities: pr.getVulnerabilitiesOutputJSON(),
 	}
 
 	b, err := json.MarshalIndent(a, "", "  ")
@@ -142,10 +261,45 @@ func (pr *PrintResults) printResultsJSON() error {
 		return err
 	}
 
-	return pr.createOutputJSON(b)
+	return pr.writeOutputFile(b, path)
+}
+
+// getVulnerabilitiesOutputJSON return the vulnerabilities of the JSON output, including the
+// suppressed ones, with their fingerprints.
+func (pr *PrintResults) getVulnerabilitiesOutputJSON() []analysisVulnerabilityOutputJSON {
+	vulnerabilities := pr.getVulnerabilitiesWithSuppressed()
+	output := make([]analysisVulnerabilityOutputJSON, 0, len(vulnerabilities))
+
+	for index := range vulnerabilities {
+		output = append(output, analysisVulnerabilityOutputJSON{
+			AnalysisVulnerabilities: vulnerabilities[index],
+			Vulnerability: vulnerabilityOutputJSON{
+				Vulnerability: vulnerabilities[index].Vulnerability,
+				Fingerprint:   pr.fingerprinter.Fingerprint(&vulnerabilities[index].Vulnerability),
+			},
+		})
+	}
+
+	return output
 }
 
-func (pr *PrintResults) printResultsSarif() error {
+// getVulnerabilitiesWithSuppressed return a new slice with the analysis vulnerabilities and
+// the suppressed ones, without changing the analysis shared with the other outputs.
+func (pr *PrintResults) getVulnerabilitiesWithSuppressed() []analysis.AnalysisVulnerabilities {
+	if len(pr.suppressedVulnerabilities) == 0 {
+		return pr.analysis.AnalysisVulnerabilities
+	}
+
+	vulnerabilities := make(
+		[]analysis.AnalysisVulnerabilities, 0,
+		len(pr.analysis.AnalysisVulnerabilities)+len(pr.suppressedVulnerabilities),
+	)
+	vulnerabilities = append(vulnerabilities, pr.analysis.AnalysisVulnerabilities...)
+
+	return append(vulnerabilities, pr.suppressedVulnerabilities...)
+}
+
+func (pr *PrintResults) printResultsSarif(path string) error {
 	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateSARIFFile)
 
 	report := pr.sarifService.ConvertVulnerabilityToSarif()
@@ -156,10 +310,10 @@ func (pr *PrintResults) printResultsSarif() error {
 		return err
 	}
 
-	return pr.createOutputJSON(b)
+	return pr.writeOutputFile(b, path)
 }
 
-func (pr *PrintResults) printResultsSonarQube() error {
+func (pr *PrintResults) printResultsSonarQube(path string) error {
 	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateSonarQubeFile)
 
 	report := pr.sonarqubeService.ConvertVulnerabilityToSonarQube()
@@ -170,7 +324,78 @@ func (pr *PrintResults) printResultsSonarQube() error {
 		return err
 	}
 
-	return pr.createOutputJSON(b)
+	return pr.writeOutputFile(b, path)
+}
+
+func (pr *PrintResults) printResultsHTML(path string) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateHTMLFile)
+
+	report := html.NewHTML(pr.analysis, pr.config.Version, pr.config.EnableCommitAuthor)
+
+	var b bytes.Buffer
+	if err := report.Write(&b); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateHTMLFile, err)
+		return err
+	}
+
+	return pr.writeOutputFile(b.Bytes(), path)
+}
+
+func (pr *PrintResults) printResultsJUnit(path string) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateJUnitFile)
+
+	report := junit.NewJUnit(pr.analysis).ConvertVulnerabilityToJUnit()
+
+	b, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
+		return err
+	}
+
+	return pr.writeOutputFile(append([]byte(xml.Header), b...), path)
+}
+
+// printResultsGitLabSAST print the GitLab SAST report with all vulnerabilities not on dependencies.
+func (pr *PrintResults) printResultsGitLabSAST(path string) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateGitLabSASTFile)
+
+	return pr.printResultsGitLab(pr.newGitLab().ConvertVulnerabilityToSAST(), path)
+}
+
+// printResultsGitLabDependencyScanning print the GitLab Dependency Scanning report with
+// the vulnerabilities on dependencies.
+func (pr *PrintResults) printResultsGitLabDependencyScanning(path string) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateGitLabDSFile)
+
+	return pr.printResultsGitLab(pr.newGitLab().ConvertVulnerabilityToDependencyScanning(), path)
+}
+
+func (pr *PrintResults) newGitLab() *gitlab.GitLab {
+	return gitlab.NewGitLab(pr.analysis, pr.config.Version, pr.config.ProjectPath)
+}
+
+func (pr *PrintResults) printResultsGitLab(report gitlab.Report, path string) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
+		return err
+	}
+
+	return pr.writeOutputFile(b, path)
+}
+
+func (pr *PrintResults) printResultsCodeClimate(path string) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateCodeClimateFile)
+
+	report := codeclimate.NewCodeClimate(pr.analysis, pr.config.ProjectPath).ConvertVulnerabilityToCodeClimate()
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
+		return err
+	}
+
+	return pr.writeOutputFile(b, path)
 }
 
 func (pr *PrintResults) checkIfExistVulnerabilityOrNoSec() {
@@ -182,7 +407,7 @@ func (pr *PrintResults) checkIfExistVulnerabilityOrNoSec() {
 }
 
 func (pr *PrintResults) validateVulnerabilityToCheckTotalErrors(vuln *vulnerability.Vulnerability) {
-	if vuln.Severity.ToString() != "" && !pr.isTypeVulnToSkip(vuln) {
+	if vuln.Severity.ToString() != "" && pr.isBlockingVulnerability(vuln) {
 		if !pr.isIgnoredVulnerability(vuln.Severity.ToString()) {
 			logger.LogDebugWithLevel(messages.MsgDebugVulnHashToFix + vuln.VulnHash)
 			pr.totalVulns++
@@ -190,6 +415,45 @@ func (pr *PrintResults) validateVulnerabilityToCheckTotalErrors(vuln *vulnerabil
 	}
 }
 
+// isBlockingVulnerability returns true if the vulnerability should be counted as an error
+// according to the fail on severity thresholds. A threshold configured to the vulnerability
+// type takes precedence over the global one, so even false positives, risk accepted and
+// corrected vulnerabilities can be made blocking.
+func (pr *PrintResults) isBlockingVulnerability(vuln *vulnerability.Vulnerability) bool {
+	threshold, ok := pr.getFailOnSeverityByType(vuln.Type)
+	if !ok {
+		if pr.isTypeVulnToSkip(vuln) {
+			return false
+		}
+
+		threshold = pr.config.FailOnSeverity
+	}
+
+	return pr.isSeverityAtLeastThreshold(vuln.Severity, threshold)
+}
+
+func (pr *PrintResults) getFailOnSeverityByType(vulnType vulnerabilityenum.Type) (string, bool) {
+	for key, threshold := range pr.config.FailOnSeverityByType {
+		if strings.EqualFold(strings.TrimSpace(key), vulnType.ToString()) {
+			return threshold, true
+		}
+	}
+
+	return "", false
+}
+
+func (pr *PrintResults) isSeverityAtLeastThreshold(vulnSeverity severities.Severity, threshold string) bool {
+	threshold = strings.ToUpper(strings.TrimSpace(threshold))
+	switch threshold {
+	case "":
+		return true
+	case config.FailOnSeverityNone:
+		return false
+	default:
+		return severity.IsAtLeast(vulnSeverity, severities.GetSeverityByString(threshold))
+	}
+}
+
 func (pr *PrintResults) isTypeVulnToSkip(vuln *vulnerability.Vulnerability) bool {
 	return vuln.Type == vulnerabilityenum.FalsePositive ||
 		vuln.Type == vulnerabilityenum.RiskAccepted ||
@@ -207,21 +471,21 @@ func (pr *PrintResults) isIgnoredVulnerability(vulnerabilityType string) bool {
 	return false
 }
 
-func (pr *PrintResults) returnDefaultErrOutputJSON(err error) error {
+func (pr *PrintResults) returnDefaultErrOutputFile(err error) error {
 	logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
 	return ErrOutputJSON
 }
 
 //nolint:funlen
-func (pr *PrintResults) createOutputJSON(content []byte) error {
-	path, err := filepath.Abs(pr.config.JSONOutputFilePath)
+func (pr *PrintResults) writeOutputFile(content []byte, outputPath string) error {
+	path, err := filepath.Abs(outputPath)
 	if err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
+		return pr.returnDefaultErrOutputFile(err)
 	}
 
 	f, err := os.Create(path)
 	if err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
+		return pr.returnDefaultErrOutputFile(err)
 	}
 
 	logger.LogInfoWithLevel(messages.MsgInfoStartWriteFile + path)
@@ -235,12 +499,12 @@ func (pr *PrintResults) createOutputJSON(content []byte) error {
 
 func (pr *PrintResults) truncateAndWriteFile(content []byte, f *os.File) error {
 	if err := f.Truncate(0); err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
+		return pr.returnDefaultErrOutputFile(err)
 	}
 
 	bytesWritten, err := f.Write(content)
 	if err != nil || bytesWritten != len(content) {
-		return pr.returnDefaultErrOutputJSON(err)
+		return pr.returnDefaultErrOutputFile(err)
 	}
 
 	return nil
@@ -326,6 +590,7 @@ func (pr *PrintResults) printTextOutputVulnerabilityData(vulnerability *vulnerab
 	pr.printCommitAuthor(vulnerability)
 
 	pr.printlnf("ReferenceHash: %s", vulnerability.VulnHash)
+	pr.printlnf("Fingerprint: %s", pr.fingerprinter.Fingerprint(vulnerability))
 
 	pr.printlnf("Details: %s", vulnerability.Details)
 
@@ -413,19 +678,17 @@ func (pr *PrintResults) getFilePath(path string) string {
 func (pr *PrintResults) printlnf(text string, args ...interface{}) {
 	msg := fmt.Sprintf(text, args...)
 
-	if pr.config.PrintOutputType == outputtype.Text {
-		pr.textOutput += fmt.Sprintln(msg)
-	}
+	pr.textOutput += fmt.Sprintln(msg)
 
 	fmt.Fprintln(pr.writer, msg)
 }
 
-func (pr *PrintResults) createTxtOutputFile() error {
-	if pr.config.PrintOutputType != outputtype.Text || pr.config.JSONOutputFilePath == "" {
+func (pr *PrintResults) createTxtOutputFile(path string) error {
+	if path == "" {
 		return nil
 	}
 
-	return file.CreateAndWriteFile(pr.textOutput, pr.config.JSONOutputFilePath)
+	return file.CreateAndWriteFile(pr.textOutput, path)
 }
 
 // printWarnings print all necessary warnings in the end of the analysis
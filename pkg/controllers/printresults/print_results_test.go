@@ -16,11 +16,13 @@ package printresults
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	entitiesAnalysis "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
@@ -35,8 +37,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
 	"github.com/mosajjal/horusec/pkg/enums/outputtype"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/codeclimate"
+	"github.com/mosajjal/horusec/pkg/services/gitlab"
 	"github.com/mosajjal/horusec/pkg/utils/testutil"
 )
 
@@ -46,6 +51,8 @@ type testcase struct {
 	name            string
 	cfg             config.Config
 	analysis        entitiesAnalysis.Analysis
+	suppressed      []entitiesAnalysis.AnalysisVulnerabilities
+	riskAcceptances riskacceptance.RiskAcceptances
 	vulnerabilities int
 	outputs         []string
 	err             bool
@@ -72,6 +79,16 @@ func TestPrintResultsStartPrintResults(t *testing.T) {
 				AnalysisVulnerabilities: []entitiesAnalysis.AnalysisVulnerabilities{},
 			},
 		},
+		{
+			name: "Should return error using an unknown output type",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType: "pdf",
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			err:      true,
+		},
 		{
 			name: "Should not return error using output type json",
 			cfg: config.Config{
@@ -127,6 +144,201 @@ func TestPrintResultsStartPrintResults(t *testing.T) {
 			},
 			vulnerabilities: 11,
 		},
+		{
+			name: "Should not return error using output type html",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType:    outputtype.HTML,
+					JSONOutputFilePath: filepath.Join(t.TempDir(), "html-output.html"),
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs:  []string{messages.MsgInfoStartGenerateHTMLFile},
+			validateFn: func(t *testing.T, tt testcase) {
+				assert.FileExists(t, tt.cfg.JSONOutputFilePath)
+
+				html := string(readFile(t, tt.cfg.JSONOutputFilePath))
+				assert.Contains(t, html, "<!DOCTYPE html>")
+				assert.Contains(t, html, "cert.pem")
+				assert.NotContains(t, html, "<script src=")
+				assert.NotContains(t, html, "<link ")
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should not return error using output type junit",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType:    outputtype.JUnit,
+					JSONOutputFilePath: filepath.Join(t.TempDir(), "junit-output.xml"),
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs:  []string{messages.MsgInfoStartGenerateJUnitFile},
+			validateFn: func(t *testing.T, tt testcase) {
+				assert.FileExists(t, tt.cfg.JSONOutputFilePath)
+
+				junit := string(readFile(t, tt.cfg.JSONOutputFilePath))
+				assert.True(t, strings.HasPrefix(junit, "<?xml"))
+				assert.Contains(t, junit, `<testsuites name="horusec" tests="11" failures="11" skipped="0">`)
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should not return error using output type gitlab sast",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType:    outputtype.GitLabSAST,
+					JSONOutputFilePath: filepath.Join(t.TempDir(), "gl-sast-report.json"),
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs:  []string{messages.MsgInfoStartGenerateGitLabSASTFile},
+			validateFn: func(t *testing.T, tt testcase) {
+				var report gitlab.Report
+				require.NoError(t, json.Unmarshal(readFile(t, tt.cfg.JSONOutputFilePath), &report))
+				assert.Equal(t, "sast", report.Scan.Type)
+				assert.Len(t, report.Vulnerabilities, 8)
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should not return error using output type gitlab dependency scanning",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType:    outputtype.GitLabDependencyScanning,
+					JSONOutputFilePath: filepath.Join(t.TempDir(), "gl-dependency-scanning-report.json"),
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs:  []string{messages.MsgInfoStartGenerateGitLabDSFile},
+			validateFn: func(t *testing.T, tt testcase) {
+				var report gitlab.Report
+				require.NoError(t, json.Unmarshal(readFile(t, tt.cfg.JSONOutputFilePath), &report))
+				assert.Equal(t, "dependency_scanning", report.Scan.Type)
+				assert.Len(t, report.Vulnerabilities, 3)
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should not return error using output type codeclimate",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType:    outputtype.CodeClimate,
+					JSONOutputFilePath: filepath.Join(t.TempDir(), "gl-code-quality-report.json"),
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs:  []string{messages.MsgInfoStartGenerateCodeClimateFile},
+			validateFn: func(t *testing.T, tt testcase) {
+				var report codeclimate.Report
+				require.NoError(t, json.Unmarshal(readFile(t, tt.cfg.JSONOutputFilePath), &report))
+				assert.Len(t, report, 11)
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should not return error using multiple outputs",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					Outputs: []string{
+						"sarif=" + filepath.Join(t.TempDir(), "output.sarif"),
+						"json=" + filepath.Join(t.TempDir(), "output.json"),
+						"text=" + filepath.Join(t.TempDir(), "output.txt"),
+					},
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs: []string{
+				messages.MsgInfoStartGenerateSARIFFile,
+				"Analysis StartedAt:",
+				"Language: Go",
+			},
+			validateFn: func(t *testing.T, tt testcase) {
+				for _, output := range tt.cfg.Outputs {
+					_, path := outputtype.Parse(output)
+					assert.FileExists(t, path)
+				}
+
+				_, jsonPath := outputtype.Parse(tt.cfg.Outputs[1])
+				assert.Contains(t, string(readFile(t, jsonPath)), `"version": ""`)
+
+				_, textPath := outputtype.Parse(tt.cfg.Outputs[2])
+				assert.Contains(t, string(readFile(t, textPath)), "Language: Go")
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should write suppressed vulnerabilities only on json output",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					Outputs: []string{
+						"json=" + filepath.Join(t.TempDir(), "output.json"),
+						"sarif=" + filepath.Join(t.TempDir(), "output.sarif"),
+						"text=" + filepath.Join(t.TempDir(), "output.txt"),
+					},
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			suppressed: []entitiesAnalysis.AnalysisVulnerabilities{
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						RuleID:   "HS-SUPPRESSED-1",
+						File:     "suppressed.go",
+						Severity: severities.High,
+						Type:     vulnerabilityenum.FalsePositive,
+						Details:  "Suppressed by horusec:ignore comment",
+					},
+				},
+			},
+			vulnerabilities: 11,
+			validateFn: func(t *testing.T, tt testcase) {
+				_, jsonPath := outputtype.Parse(tt.cfg.Outputs[0])
+				assert.Contains(t, string(readFile(t, jsonPath)), "HS-SUPPRESSED-1")
+
+				for _, output := range tt.cfg.Outputs[1:] {
+					_, path := outputtype.Parse(output)
+					assert.NotContains(t, string(readFile(t, path)), "HS-SUPPRESSED-1")
+				}
+			},
+		},
+		{
+			name: "Should merge output format into multiple outputs",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					PrintOutputType:    outputtype.JSON,
+					JSONOutputFilePath: filepath.Join(t.TempDir(), "output.json"),
+					Outputs:            []string{"sarif=" + filepath.Join(t.TempDir(), "output.sarif")},
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs: []string{
+				"was set together with --output",
+				messages.MsgInfoStartGenerateSARIFFile,
+				"Language: Go",
+			},
+			validateFn: func(t *testing.T, tt testcase) {
+				_, sarifPath := outputtype.Parse(tt.cfg.Outputs[0])
+				assert.FileExists(t, sarifPath)
+				assert.FileExists(t, tt.cfg.JSONOutputFilePath)
+				assert.Len(t, tt.cfg.Outputs, 1)
+			},
+			vulnerabilities: 11,
+		},
+		{
+			name: "Should print text summary on stdout using multiple outputs without text",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					Outputs: []string{"codeclimate=" + filepath.Join(t.TempDir(), "output.json")},
+				},
+			},
+			analysis: *testutil.CreateAnalysisMock(),
+			outputs: []string{
+				messages.MsgInfoStartGenerateCodeClimateFile,
+				"Language: Go",
+			},
+			vulnerabilities: 11,
+		},
 		{
 			name: "Should return not errors because exists error in analysis",
 			cfg:  config.Config{},
@@ -186,6 +398,116 @@ func TestPrintResultsStartPrintResults(t *testing.T) {
 			analysis:        *testutil.CreateAnalysisMock(),
 			vulnerabilities: 3,
 		},
+		{
+			name: "Should count only vulnerabilities with severity HIGH or above when fail on severity is HIGH",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					FailOnSeverity: "high",
+				},
+			},
+			analysis:        *testutil.CreateAnalysisMock(),
+			vulnerabilities: 3,
+		},
+		{
+			name: "Should not count vulnerabilities when fail on severity is NONE",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					FailOnSeverity: config.FailOnSeverityNone,
+				},
+			},
+			analysis:        *testutil.CreateAnalysisMock(),
+			vulnerabilities: 0,
+		},
+		{
+			name: "Should use fail on severity by type instead of the global fail on severity",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					FailOnSeverity: "HIGH",
+					FailOnSeverityByType: map[string]string{
+						"vulnerability": "MEDIUM",
+						vulnerabilityenum.RiskAccepted.ToString(): config.FailOnSeverityNone,
+					},
+				},
+			},
+			analysis:        *testutil.CreateAnalysisMock(),
+			vulnerabilities: 4,
+		},
+		{
+			name: "Should count risk accepted vulnerabilities when configured a fail on severity to its type",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					FailOnSeverity: "HIGH",
+					FailOnSeverityByType: map[string]string{
+						vulnerabilityenum.RiskAccepted.ToString(): "HIGH",
+					},
+				},
+			},
+			analysis: entitiesAnalysis.Analysis{
+				AnalysisVulnerabilities: []entitiesAnalysis.AnalysisVulnerabilities{
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							File:     "main.go",
+							Severity: severities.Low,
+							Type:     vulnerabilityenum.Vulnerability,
+						},
+					},
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							File:     "main.go",
+							Severity: severities.High,
+							Type:     vulnerabilityenum.RiskAccepted,
+						},
+					},
+					{
+						Vulnerability: vulnerability.Vulnerability{
+							File:     "main.go",
+							Severity: severities.Critical,
+							Type:     vulnerabilityenum.FalsePositive,
+						},
+					},
+				},
+			},
+			vulnerabilities: 1,
+		},
+		{
+			name: "Should warn about risk acceptances expiring within the warning days",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					RiskAcceptanceWarningDays: 30,
+					RiskAcceptances: riskacceptance.RiskAcceptances{
+						{
+							Hash:      "expiring-hash",
+							ExpiresAt: time.Now().AddDate(0, 0, 10).Format("2006-01-02"),
+							Owner:     "security-team",
+							Ticket:    "SEC-1",
+						},
+						{Hash: "not-expiring-hash", ExpiresAt: time.Now().AddDate(0, 0, 60).Format("2006-01-02")},
+					},
+				},
+			},
+			analysis:        *testutil.CreateAnalysisMock(),
+			vulnerabilities: 11,
+			outputs:         []string{"Risk acceptance of vulnerability expiring-hash will expire", "SEC-1"},
+		},
+		{
+			name: "Should warn about risk acceptances of policy expiring within the warning days",
+			cfg: config.Config{
+				StartOptions: config.StartOptions{
+					RiskAcceptanceWarningDays: 30,
+				},
+			},
+			riskAcceptances: riskacceptance.RiskAcceptances{
+				{
+					Hash:      "policy-hash",
+					ExpiresAt: time.Now().AddDate(0, 0, 10).Format("2006-01-02"),
+					Owner:     "security-team",
+					Ticket:    "SEC-2",
+				},
+			},
+			analysis:        *testutil.CreateAnalysisMock(),
+			vulnerabilities: 11,
+			outputs:         []string{"Risk acceptance of vulnerability policy-hash will expire", "SEC-2"},
+		},
 		{
 			name: "Should save output to file when using json output file path and text format",
 			cfg: config.Config{
@@ -225,6 +547,8 @@ func TestPrintResultsStartPrintResults(t *testing.T) {
 	for _, tt := range testcases {
 		t.Run(tt.name, func(t *testing.T) {
 			pr, output := newPrintResultsTest(&tt.analysis, &tt.cfg)
+			pr.SetSuppressedVulnerabilities(tt.suppressed)
+			pr.SetRiskAcceptances(tt.riskAcceptances)
 			totalVulns, err := pr.Print()
 
 			if tt.err {
@@ -290,6 +614,7 @@ const (
         "column": "0",
         "confidence": "HIGH",
         "file": "cert.pem",
+        "fingerprint": "e26bbe5ccd1457b43a8b307645324f2730eb963a3b795adad68deab8cbc94346",
         "code": "-----BEGIN CERTIFICATE-----",
         "details": "Found SSH and/or x.509 Cerficates GoSec",
         "securityTool": "GoSec",
@@ -327,7 +652,7 @@ Code: -----BEGIN CERTIFICATE-----
 Details: Found SSH and/or x.509 Cerficates GoSec
 Type: Vulnerability
 ReferenceHash: 03405f909c9ed621e2bccd9e50d237dbe9374e4c67f89c1018d70fa9a4912d71
-
+Fingerprint: e26bbe5ccd1457b43a8b307645324f2730eb963a3b795adad68deab8cbc94346
 ==================================================================================
 
 Language: C#
@@ -0,0 +1,158 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+const (
+	// DefaultReason is the reason used on vulnerabilities of a baseline when no reason is informed.
+	DefaultReason = "Vulnerability already present when the baseline was created"
+
+	// DetailsPrefix is the prefix of the line added on details of a vulnerability accepted by
+	// a baseline, that shows the reason of the baseline on the outputs.
+	DetailsPrefix = "Accepted by baseline"
+)
+
+// Baseline contains the vulnerabilities that already exists on a project, so they can
+// be accepted in new analysis and only the new ones are reported.
+type Baseline struct {
+	Version         string          `json:"version"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+// Vulnerability is a vulnerability accepted on a baseline. A vulnerability of an analysis
+// is on baseline if its hash or its fingerprint is equal to the ones of the baseline.
+type Vulnerability struct {
+	VulnHash    string `json:"vulnHash"`
+	RuleID      string `json:"ruleID"`
+	File        string `json:"file"`
+	Fingerprint string `json:"fingerprint"`
+	Reason      string `json:"reason"`
+}
+
+// New create a new baseline with all vulnerabilities of analysis that are not already
+// set as false positive, risk accepted or corrected. The fingerprinter should use the
+// project path of the analysis, so the fingerprints could be matched on next analysis.
+func New(entity *analysis.Analysis, version, reason string, fingerprinter *vulnhash.Fingerprinter) *Baseline {
+	if reason == "" {
+		reason = DefaultReason
+	}
+
+	baseline := &Baseline{
+		Version:         version,
+		CreatedAt:       time.Now(),
+		Vulnerabilities: make([]Vulnerability, 0),
+	}
+
+	for index := range entity.AnalysisVulnerabilities {
+		vuln := entity.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.VulnHash == "" || (vuln.Type != "" && vuln.Type != enumsVulnerability.Vulnerability) {
+			continue
+		}
+
+		baseline.Vulnerabilities = append(baseline.Vulnerabilities, Vulnerability{
+			VulnHash:    vuln.VulnHash,
+			RuleID:      vuln.RuleID,
+			File:        vuln.File,
+			Fingerprint: fingerprinter.Fingerprint(&vuln),
+			Reason:      reason,
+		})
+	}
+
+	return baseline
+}
+
+// Details return the text added on details of a vulnerability accepted by v.
+func (v *Vulnerability) Details() string {
+	if v.Reason == "" {
+		return DetailsPrefix
+	}
+
+	return fmt.Sprintf("%s: %s", DetailsPrefix, v.Reason)
+}
+
+// Parse read and parse the baseline file from path.
+func Parse(path string) (*Baseline, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := new(Baseline)
+	if err := json.Unmarshal(content, baseline); err != nil {
+		return nil, err
+	}
+
+	return baseline, nil
+}
+
+// Write write the baseline as JSON on path.
+func (b *Baseline) Write(path string) error {
+	content, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o600)
+}
+
+// Hashes return the hashes of the vulnerabilities of analysis that are on baseline,
+// which can be used as risk accepted hashes.
+func (b *Baseline) Hashes(
+	vulnerabilities []analysis.AnalysisVulnerabilities, fingerprinter *vulnhash.Fingerprinter,
+) (hashes []string) {
+	vulnHashes := make(map[string]bool, len(b.Vulnerabilities))
+	fingerprints := make(map[string]bool, len(b.Vulnerabilities))
+
+	for _, vuln := range b.Vulnerabilities {
+		vulnHashes[vuln.VulnHash] = vuln.VulnHash != ""
+		fingerprints[vuln.Fingerprint] = vuln.Fingerprint != ""
+	}
+
+	for index := range vulnerabilities {
+		vuln := vulnerabilities[index].Vulnerability
+		if vulnHashes[vuln.VulnHash] || fingerprints[fingerprinter.Fingerprint(&vuln)] {
+			hashes = append(hashes, vuln.VulnHash)
+		}
+	}
+
+	return hashes
+}
+
+// Find return the vulnerability of baseline with the same hash or fingerprint of vuln, or nil
+// if vuln is not on baseline.
+func (b *Baseline) Find(vuln *vulnerability.Vulnerability, fingerprint string) *Vulnerability {
+	for index := range b.Vulnerabilities {
+		accepted := &b.Vulnerabilities[index]
+		if (accepted.VulnHash != "" && accepted.VulnHash == vuln.VulnHash) ||
+			(accepted.Fingerprint != "" && accepted.Fingerprint == fingerprint) {
+			return accepted
+		}
+	}
+
+	return nil
+}
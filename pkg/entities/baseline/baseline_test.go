@@ -0,0 +1,115 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+func TestBaseline(t *testing.T) {
+	entity := &analysis.Analysis{
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			newAnalysisVulnerability("HS-GO-1", "main.go", "10", `exec.Command(input)`, enumsVulnerability.Vulnerability),
+			newAnalysisVulnerability("HS-GO-2", "main.go", "20", `md5.New()`, enumsVulnerability.FalsePositive),
+		},
+	}
+
+	fingerprinter := vulnhash.NewFingerprinter(t.TempDir())
+
+	t.Run("Should create baseline only with vulnerabilities that are not accepted", func(t *testing.T) {
+		created := New(entity, "v2.9.0", "", fingerprinter)
+
+		require.Len(t, created.Vulnerabilities, 1)
+		assert.Equal(t, "v2.9.0", created.Version)
+		assert.Equal(t, Vulnerability{
+			VulnHash:    entity.AnalysisVulnerabilities[0].Vulnerability.VulnHash,
+			RuleID:      "HS-GO-1",
+			File:        "main.go",
+			Fingerprint: vulnhash.Fingerprint(&entity.AnalysisVulnerabilities[0].Vulnerability, nil),
+			Reason:      DefaultReason,
+		}, created.Vulnerabilities[0])
+	})
+
+	t.Run("Should write and parse baseline file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "horusec-baseline.json")
+		created := New(entity, "v2.9.0", "legacy code", fingerprinter)
+
+		require.NoError(t, created.Write(path))
+
+		parsed, err := Parse(path)
+		require.NoError(t, err)
+		assert.Equal(t, created.Vulnerabilities, parsed.Vulnerabilities)
+	})
+
+	t.Run("Should return error when parse baseline file that not exists", func(t *testing.T) {
+		_, err := Parse(filepath.Join(t.TempDir(), "not-exists.json"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return hashes of vulnerabilities on baseline by hash or fingerprint", func(t *testing.T) {
+		created := New(entity, "v2.9.0", "", fingerprinter)
+
+		moved := newAnalysisVulnerability("HS-GO-1", "main.go", "15", `exec.Command(input)`,
+			enumsVulnerability.Vulnerability)
+		added := newAnalysisVulnerability("HS-GO-3", "main.go", "30", `sql.Query(input)`,
+			enumsVulnerability.Vulnerability)
+
+		hashes := created.Hashes([]analysis.AnalysisVulnerabilities{entity.AnalysisVulnerabilities[0], moved, added},
+			fingerprinter)
+
+		assert.Equal(t, []string{
+			entity.AnalysisVulnerabilities[0].Vulnerability.VulnHash,
+			moved.Vulnerability.VulnHash,
+		}, hashes)
+	})
+
+	t.Run("Should find vulnerabilities on baseline by hash or fingerprint", func(t *testing.T) {
+		created := New(entity, "v2.9.0", "legacy code", fingerprinter)
+
+		moved := newAnalysisVulnerability("HS-GO-1", "main.go", "15", `exec.Command(input)`,
+			enumsVulnerability.Vulnerability).Vulnerability
+		added := newAnalysisVulnerability("HS-GO-3", "main.go", "30", `sql.Query(input)`,
+			enumsVulnerability.Vulnerability).Vulnerability
+
+		found := created.Find(&moved, fingerprinter.Fingerprint(&moved))
+		require.NotNil(t, found)
+		assert.Equal(t, "Accepted by baseline: legacy code", found.Details())
+
+		assert.Nil(t, created.Find(&added, fingerprinter.Fingerprint(&added)))
+	})
+}
+
+func newAnalysisVulnerability(ruleID, file, line, code string,
+	vulnType enumsVulnerability.Type,
+) analysis.AnalysisVulnerabilities {
+	vuln := vulnerability.Vulnerability{
+		RuleID: ruleID,
+		File:   file,
+		Line:   line,
+		Code:   code,
+		Type:   vulnType,
+	}
+
+	return analysis.AnalysisVulnerabilities{Vulnerability: *vulnhash.Bind(&vuln)}
+}
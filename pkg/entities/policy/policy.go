@@ -0,0 +1,206 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/bmatcuk/doublestar/v4"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+// AllRules can be used on Path.DisabledRules to disable all rules on a path.
+const AllRules = "*"
+
+// Policy contains the rules of engagement of a project that are applied on the
+// vulnerabilities of an analysis after the false positive and risk accepted hashes.
+type Policy struct {
+	Rules                []Rule                         `json:"rules" yaml:"rules"`
+	Paths                []Path                         `json:"paths" yaml:"paths"`
+	RiskAcceptances      riskacceptance.RiskAcceptances `json:"riskAcceptances" yaml:"riskAcceptances"`
+	RequireJustification bool                           `json:"requireJustification" yaml:"requireJustification"`
+}
+
+// Rule overrides the severity of all vulnerabilities found by the rule with ID.
+type Rule struct {
+	ID       string `json:"id" yaml:"id"`
+	Severity string `json:"severity" yaml:"severity"`
+}
+
+// Path disables rules on the files that match the Glob pattern, e.g. "**/*_test.go".
+type Path struct {
+	Glob          string   `json:"glob" yaml:"glob"`
+	DisabledRules []string `json:"disabledRules" yaml:"disabledRules"`
+}
+
+// Parse read and parse the policy file from path. Files with .json extension are
+// parsed as JSON and all others as YAML.
+func Parse(path string) (*Policy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := new(Policy)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(content, policy)
+	} else {
+		err = yaml.Unmarshal(content, policy)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, policy.Validate()
+}
+
+// Validate return an error if some severity, glob pattern or risk acceptance of policy is invalid.
+func (p *Policy) Validate() error {
+	for _, rule := range p.Rules {
+		if rule.Severity != "" && !severities.Contains(rule.Severity) {
+			return fmt.Errorf("%s%s", messages.MsgErrorInvalidPolicySeverity, rule.Severity)
+		}
+	}
+
+	for _, path := range p.Paths {
+		if !doublestar.ValidatePattern(filepath.ToSlash(path.Glob)) {
+			return fmt.Errorf("%s%s", messages.MsgErrorInvalidPolicyGlob, path.Glob)
+		}
+	}
+
+	for index := range p.RiskAcceptances {
+		if strings.TrimSpace(p.RiskAcceptances[index].Hash) == "" {
+			return errors.New(messages.MsgErrorInvalidPolicyRiskAcceptance)
+		}
+
+		if _, err := p.RiskAcceptances[index].Expiration(); err != nil {
+			return fmt.Errorf("%s%s", messages.MsgErrorInvalidPolicyExpiresAt, p.RiskAcceptances[index].ExpiresAt)
+		}
+	}
+
+	return nil
+}
+
+// Evaluate apply the policy on the vulnerabilities of entity and return the policy violations.
+//
+// Vulnerabilities of rules disabled on its path are removed and the severity of vulnerabilities
+// with a rule on policy is overridden. Risk acceptances of policy set the vulnerabilities as risk
+// accepted, while expired ones and the ones without justification, when it is required, are set
+// back as vulnerabilities and reported as violations. Risk acceptances match the vulnerability
+// hash or the fingerprint generated by fingerprinter. The justified hashes are the ones already
+// justified in another place, e.g. a baseline file.
+func (p *Policy) Evaluate(
+	entity *analysis.Analysis, justified []string, fingerprinter *vulnhash.Fingerprinter,
+) (violations []error) {
+	justifiedHashes := make(map[string]bool, len(justified))
+	for _, hash := range justified {
+		justifiedHashes[hash] = true
+	}
+
+	vulnerabilities := make([]analysis.AnalysisVulnerabilities, 0, len(entity.AnalysisVulnerabilities))
+	for index := range entity.AnalysisVulnerabilities {
+		vuln := &entity.AnalysisVulnerabilities[index].Vulnerability
+		if p.isRuleDisabled(vuln) {
+			continue
+		}
+
+		p.overrideSeverity(vuln)
+		acceptance := p.RiskAcceptances.Find(vuln, fingerprinter.Fingerprint(vuln))
+		if err := p.evaluateRiskAcceptance(vuln, acceptance, justifiedHashes[vuln.VulnHash]); err != nil {
+			violations = append(violations, err)
+		}
+
+		vulnerabilities = append(vulnerabilities, entity.AnalysisVulnerabilities[index])
+	}
+
+	entity.AnalysisVulnerabilities = vulnerabilities
+
+	return violations
+}
+
+func (p *Policy) isRuleDisabled(vuln *vulnerability.Vulnerability) bool {
+	file := filepath.ToSlash(vuln.File)
+	for _, path := range p.Paths {
+		if matched, _ := doublestar.Match(filepath.ToSlash(path.Glob), file); !matched {
+			continue
+		}
+
+		for _, ruleID := range path.DisabledRules {
+			if ruleID == AllRules || strings.EqualFold(ruleID, vuln.RuleID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *Policy) overrideSeverity(vuln *vulnerability.Vulnerability) {
+	for _, rule := range p.Rules {
+		if rule.Severity != "" && strings.EqualFold(rule.ID, vuln.RuleID) {
+			vuln.Severity = severities.GetSeverityByString(strings.ToUpper(rule.Severity))
+		}
+	}
+}
+
+// evaluateRiskAcceptance set the type of vulnerability according to its risk acceptance on policy
+// and return an error if it violates the policy. False positive and corrected vulnerabilities are
+// never changed.
+func (p *Policy) evaluateRiskAcceptance(
+	vuln *vulnerability.Vulnerability, acceptance *riskacceptance.RiskAcceptance, justified bool,
+) error {
+	if vuln.Type != enumsVulnerability.Vulnerability && vuln.Type != enumsVulnerability.RiskAccepted {
+		return nil
+	}
+
+	if acceptance == nil {
+		if vuln.Type == enumsVulnerability.RiskAccepted && p.RequireJustification && !justified {
+			vuln.Type = enumsVulnerability.Vulnerability
+			return fmt.Errorf(messages.MsgErrorPolicyMissingJustification, vuln.VulnHash)
+		}
+
+		return nil
+	}
+
+	if acceptance.IsExpired(time.Now()) {
+		vuln.Type = enumsVulnerability.Vulnerability
+		return fmt.Errorf(messages.MsgErrorPolicyRiskAcceptanceExpired,
+			vuln.VulnHash, acceptance.ExpiresAt, acceptance.Owner, acceptance.Ticket)
+	}
+
+	if p.RequireJustification && strings.TrimSpace(acceptance.Justification) == "" && !justified {
+		vuln.Type = enumsVulnerability.Vulnerability
+		return fmt.Errorf(messages.MsgErrorPolicyMissingJustification, vuln.VulnHash)
+	}
+
+	vuln.Type = enumsVulnerability.RiskAccepted
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+const policyYAML = `
+requireJustification: true
+rules:
+  - id: HS-GO-1
+    severity: low
+paths:
+  - glob: "**/*_test.go"
+    disabledRules: ["HS-GO-2"]
+riskAcceptances:
+  - hash: hash3
+    expires_at: 2999-12-31
+    owner: security-team
+    ticket: SEC-1
+    justification: Only reachable by administrators
+`
+
+func TestParse(t *testing.T) {
+	t.Run("Should parse YAML policy file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "horusec-policy.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(policyYAML), 0o600))
+
+		policy, err := Parse(path)
+		require.NoError(t, err)
+
+		assert.True(t, policy.RequireJustification)
+		assert.Equal(t, []Rule{{ID: "HS-GO-1", Severity: "low"}}, policy.Rules)
+		assert.Equal(t, []Path{{Glob: "**/*_test.go", DisabledRules: []string{"HS-GO-2"}}}, policy.Paths)
+		assert.Equal(t, riskacceptance.RiskAcceptances{
+			{
+				Hash:          "hash3",
+				ExpiresAt:     "2999-12-31",
+				Owner:         "security-team",
+				Ticket:        "SEC-1",
+				Justification: "Only reachable by administrators",
+			},
+		}, policy.RiskAcceptances)
+	})
+
+	t.Run("Should parse JSON policy file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "horusec-policy.json")
+		content := `{"rules": [{"id": "HS-GO-1", "severity": "HIGH"}], "riskAcceptances": [{"hash": "hash1"}]}`
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		policy, err := Parse(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, []Rule{{ID: "HS-GO-1", Severity: "HIGH"}}, policy.Rules)
+		assert.Equal(t, riskacceptance.RiskAcceptances{{Hash: "hash1"}}, policy.RiskAcceptances)
+	})
+
+	t.Run("Should return error when policy file does not exist", func(t *testing.T) {
+		_, err := Parse(filepath.Join(t.TempDir(), "horusec-policy.yaml"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return error when policy is invalid", func(t *testing.T) {
+		testcases := []struct {
+			policy   Policy
+			expected string
+		}{
+			{
+				policy:   Policy{Rules: []Rule{{ID: "HS-GO-1", Severity: "test"}}},
+				expected: messages.MsgErrorInvalidPolicySeverity + "test",
+			},
+			{
+				policy:   Policy{Paths: []Path{{Glob: "[test"}}},
+				expected: messages.MsgErrorInvalidPolicyGlob + "[test",
+			},
+			{
+				policy:   Policy{RiskAcceptances: riskacceptance.RiskAcceptances{{ExpiresAt: "2999-12-31"}}},
+				expected: messages.MsgErrorInvalidPolicyRiskAcceptance,
+			},
+			{
+				policy:   Policy{RiskAcceptances: riskacceptance.RiskAcceptances{{Hash: "hash1", ExpiresAt: "31/12/2999"}}},
+				expected: messages.MsgErrorInvalidPolicyExpiresAt + "31/12/2999",
+			},
+		}
+
+		for _, tt := range testcases {
+			assert.EqualError(t, tt.policy.Validate(), tt.expected)
+		}
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	newAnalysis := func() *analysis.Analysis {
+		return &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				newAnalysisVulnerability("hash1", "HS-GO-1", "main.go", enumsVulnerability.Vulnerability),
+				newAnalysisVulnerability("hash2", "HS-GO-2", "main_test.go", enumsVulnerability.Vulnerability),
+				newAnalysisVulnerability("hash3", "HS-GO-2", "main.go", enumsVulnerability.Vulnerability),
+				newAnalysisVulnerability("hash4", "HS-GO-3", "main.go", enumsVulnerability.RiskAccepted),
+				newAnalysisVulnerability("hash5", "HS-GO-3", "main.go", enumsVulnerability.FalsePositive),
+			},
+		}
+	}
+
+	policy := &Policy{
+		RequireJustification: true,
+		Rules:                []Rule{{ID: "hs-go-1", Severity: "LOW"}},
+		Paths:                []Path{{Glob: "**/*_test.go", DisabledRules: []string{"HS-GO-2"}}},
+		RiskAcceptances: riskacceptance.RiskAcceptances{
+			{Hash: "hash3", ExpiresAt: "2999-12-31", Justification: "Only reachable by administrators"},
+		},
+	}
+
+	t.Run("Should apply policy on vulnerabilities and return violations", func(t *testing.T) {
+		entity := newAnalysis()
+
+		violations := policy.Evaluate(entity, nil, nil)
+
+		require.Len(t, entity.AnalysisVulnerabilities, 4)
+		assert.Equal(t, "hash1", entity.AnalysisVulnerabilities[0].Vulnerability.VulnHash)
+		assert.Equal(t, severities.Low, entity.AnalysisVulnerabilities[0].Vulnerability.Severity)
+		assert.Equal(t, severities.High, entity.AnalysisVulnerabilities[1].Vulnerability.Severity)
+		assert.Equal(t, enumsVulnerability.RiskAccepted, entity.AnalysisVulnerabilities[1].Vulnerability.Type)
+		assert.Equal(t, enumsVulnerability.Vulnerability, entity.AnalysisVulnerabilities[2].Vulnerability.Type)
+		assert.Equal(t, enumsVulnerability.FalsePositive, entity.AnalysisVulnerabilities[3].Vulnerability.Type)
+
+		require.Len(t, violations, 1)
+		assert.Contains(t, violations[0].Error(), "hash4")
+	})
+
+	t.Run("Should not return violation when risk accepted vulnerability is justified", func(t *testing.T) {
+		entity := newAnalysis()
+
+		violations := policy.Evaluate(entity, []string{"hash4"}, nil)
+
+		assert.Empty(t, violations)
+		assert.Equal(t, enumsVulnerability.RiskAccepted, entity.AnalysisVulnerabilities[2].Vulnerability.Type)
+	})
+
+	t.Run("Should return violation when risk acceptance is expired", func(t *testing.T) {
+		entity := newAnalysis()
+		expired := &Policy{RiskAcceptances: riskacceptance.RiskAcceptances{
+			{Hash: "hash4", ExpiresAt: "2000-01-01", Owner: "security-team", Ticket: "SEC-1"},
+		}}
+
+		violations := expired.Evaluate(entity, nil, nil)
+
+		require.Len(t, violations, 1)
+		assert.Contains(t, violations[0].Error(), "expired at 2000-01-01 (owner: security-team, ticket: SEC-1)")
+		assert.Equal(t, enumsVulnerability.Vulnerability, entity.AnalysisVulnerabilities[3].Vulnerability.Type)
+	})
+
+	t.Run("Should accept risk of vulnerability by its fingerprint", func(t *testing.T) {
+		entity := newAnalysis()
+		vuln := entity.AnalysisVulnerabilities[0].Vulnerability
+		byFingerprint := &Policy{RiskAcceptances: riskacceptance.RiskAcceptances{
+			{Hash: vulnhash.Fingerprint(&vuln, nil)},
+		}}
+
+		violations := byFingerprint.Evaluate(entity, nil, nil)
+
+		assert.Empty(t, violations)
+		assert.Equal(t, enumsVulnerability.RiskAccepted, entity.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
+}
+
+func newAnalysisVulnerability(
+	hash, ruleID, file string, vulnType enumsVulnerability.Type,
+) analysis.AnalysisVulnerabilities {
+	return analysis.AnalysisVulnerabilities{
+		Vulnerability: vulnerability.Vulnerability{
+			VulnHash: hash,
+			RuleID:   ruleID,
+			File:     file,
+			Severity: severities.High,
+			Type:     vulnType,
+		},
+	}
+}
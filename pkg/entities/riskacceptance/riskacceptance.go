@@ -0,0 +1,171 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package riskacceptance
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+// dateLayout is the layout of expiration dates without time. A risk acceptance with
+// an expiration date without time is valid until the end of that day.
+const dateLayout = "2006-01-02"
+
+// RiskAcceptance accept the risk of the vulnerability with Hash until ExpiresAt, that uses the
+// format YYYY-MM-DD or RFC3339. The hash can be the vulnerability hash or its fingerprint. An
+// empty ExpiresAt never expires. It's used by both the config and the policy file.
+type RiskAcceptance struct {
+	Hash          string `json:"hash" yaml:"hash"`
+	ExpiresAt     string `json:"expires_at" yaml:"expires_at"`
+	Owner         string `json:"owner" yaml:"owner"`
+	Ticket        string `json:"ticket" yaml:"ticket"`
+	Justification string `json:"justification" yaml:"justification"`
+}
+
+// RiskAcceptances is a list of risk acceptances.
+type RiskAcceptances []RiskAcceptance
+
+// ParseExpiration parse an expiration date on format YYYY-MM-DD or RFC3339. The zero time
+// is returned if value is empty.
+func ParseExpiration(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if date, err := time.Parse(dateLayout, value); err == nil {
+		return date.AddDate(0, 0, 1), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// MustParseRiskAcceptances parse input, that can be a JSON string or the value
+// read from config file, to RiskAcceptances.
+//
+// If some error occur an empty list will be returned and the error will be logged.
+func MustParseRiskAcceptances(input interface{}) RiskAcceptances {
+	acceptances, err := parseRiskAcceptances(input)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorParseRiskAcceptances, err)
+		return RiskAcceptances{}
+	}
+
+	return acceptances
+}
+
+func parseRiskAcceptances(input interface{}) (acceptances RiskAcceptances, err error) {
+	content, isString := input.(string)
+	bytes := []byte(content)
+
+	if !isString {
+		if bytes, err = json.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(bytes, &acceptances); err != nil {
+		return nil, err
+	}
+
+	return acceptances, nil
+}
+
+// Expiration return the time that the risk acceptance expires.
+func (r *RiskAcceptance) Expiration() (time.Time, error) {
+	return ParseExpiration(r.ExpiresAt)
+}
+
+// IsExpired return true if the risk acceptance is expired on now.
+// Invalid expiration dates are considered expired.
+func (r *RiskAcceptance) IsExpired(now time.Time) bool {
+	expiration, err := r.Expiration()
+	if err != nil {
+		return true
+	}
+
+	return !expiration.IsZero() && !now.Before(expiration)
+}
+
+// ExpiresWithin return true if the risk acceptance is not expired on now
+// but will be expired after the given days.
+func (r *RiskAcceptance) ExpiresWithin(now time.Time, days int64) bool {
+	if r.ExpiresAt == "" || r.IsExpired(now) {
+		return false
+	}
+
+	return r.IsExpired(now.AddDate(0, 0, int(days)))
+}
+
+// Matches return true if the hash of the risk acceptance is the vulnerability hash, one of its
+// deprecated hashes or its fingerprint.
+func (r *RiskAcceptance) Matches(vuln *vulnerability.Vulnerability, fingerprint string) bool {
+	hash := strings.TrimSpace(r.Hash)
+	if hash == "" {
+		return false
+	}
+
+	if hash == strings.TrimSpace(vuln.VulnHash) || hash == fingerprint {
+		return true
+	}
+
+	// See vulnerability.Vulnerability.DeprecatedHashes docs for more info.
+	for _, deprecatedHash := range vuln.DeprecatedHashes {
+		if hash == strings.TrimSpace(deprecatedHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hashes return the hashes of all risk acceptances.
+func (r RiskAcceptances) Hashes() []string {
+	hashes := make([]string, 0, len(r))
+	for index := range r {
+		hashes = append(hashes, r[index].Hash)
+	}
+
+	return hashes
+}
+
+// Find return the risk acceptance of the vulnerability with fingerprint or nil if there is none.
+func (r RiskAcceptances) Find(vuln *vulnerability.Vulnerability, fingerprint string) *RiskAcceptance {
+	for index := range r {
+		if r[index].Matches(vuln, fingerprint) {
+			return &r[index]
+		}
+	}
+
+	return nil
+}
+
+// ExpiringWithin return the risk acceptances that are not expired on now,
+// but will be expired after the given days.
+func (r RiskAcceptances) ExpiringWithin(now time.Time, days int64) RiskAcceptances {
+	expiring := make(RiskAcceptances, 0)
+	for index := range r {
+		if r[index].ExpiresWithin(now, days) {
+			expiring = append(expiring, r[index])
+		}
+	}
+
+	return expiring
+}
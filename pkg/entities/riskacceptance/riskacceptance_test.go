@@ -0,0 +1,113 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package riskacceptance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpiration(t *testing.T) {
+	t.Run("Should return zero time when expiration is empty", func(t *testing.T) {
+		expiration, err := ParseExpiration("")
+		assert.NoError(t, err)
+		assert.True(t, expiration.IsZero())
+	})
+
+	t.Run("Should return the end of day when expiration is a date", func(t *testing.T) {
+		expiration, err := ParseExpiration("2026-06-15")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 6, 16, 0, 0, 0, 0, time.UTC), expiration)
+	})
+
+	t.Run("Should parse RFC3339 expiration", func(t *testing.T) {
+		expiration, err := ParseExpiration("2026-06-15T10:00:00Z")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC), expiration)
+	})
+
+	t.Run("Should return error when expiration is invalid", func(t *testing.T) {
+		_, err := ParseExpiration("15/06/2026")
+		assert.Error(t, err)
+	})
+}
+
+func TestMustParseRiskAcceptances(t *testing.T) {
+	expected := RiskAcceptances{
+		{Hash: "hash1", ExpiresAt: "2026-06-15", Owner: "security-team", Ticket: "SEC-1"},
+	}
+
+	t.Run("Should parse risk acceptances from JSON string", func(t *testing.T) {
+		acceptances := MustParseRiskAcceptances(
+			`[{"hash": "hash1", "expires_at": "2026-06-15", "owner": "security-team", "ticket": "SEC-1"}]`,
+		)
+		assert.Equal(t, expected, acceptances)
+	})
+
+	t.Run("Should parse risk acceptances from config file values", func(t *testing.T) {
+		acceptances := MustParseRiskAcceptances([]interface{}{
+			map[string]interface{}{
+				"hash": "hash1", "expires_at": "2026-06-15", "owner": "security-team", "ticket": "SEC-1",
+			},
+		})
+		assert.Equal(t, expected, acceptances)
+	})
+
+	t.Run("Should return empty risk acceptances when input is invalid", func(t *testing.T) {
+		assert.Empty(t, MustParseRiskAcceptances("invalid"))
+	})
+}
+
+func TestRiskAcceptances(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	acceptances := RiskAcceptances{
+		{Hash: "hash1"},
+		{Hash: "hash2", ExpiresAt: "2026-06-14"},
+		{Hash: "hash3", ExpiresAt: "2026-06-15"},
+		{Hash: "hash4", ExpiresAt: "2026-06-20"},
+		{Hash: "hash5", ExpiresAt: "2026-12-31"},
+		{Hash: "hash6", ExpiresAt: "invalid"},
+	}
+
+	t.Run("Should return all hashes", func(t *testing.T) {
+		assert.Equal(t, []string{"hash1", "hash2", "hash3", "hash4", "hash5", "hash6"}, acceptances.Hashes())
+	})
+
+	t.Run("Should return if risk acceptances are expired", func(t *testing.T) {
+		for index, expired := range []bool{false, true, false, false, false, true} {
+			assert.Equal(t, expired, acceptances[index].IsExpired(now), acceptances[index].Hash)
+		}
+	})
+
+	t.Run("Should find risk acceptance by vulnerability hash, deprecated hash or fingerprint", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{VulnHash: "hash1", DeprecatedHashes: []string{"old"}}
+
+		assert.Equal(t, &acceptances[0], acceptances.Find(vuln, "unknown"))
+		assert.Equal(t, &acceptances[1], acceptances.Find(&vulnerability.Vulnerability{VulnHash: "other"}, "hash2"))
+		assert.Equal(t, &acceptances[2], acceptances.Find(
+			&vulnerability.Vulnerability{VulnHash: "other", DeprecatedHashes: []string{" hash3 "}}, "",
+		))
+		assert.Nil(t, acceptances.Find(&vulnerability.Vulnerability{VulnHash: "other"}, "unknown"))
+		assert.Nil(t, RiskAcceptances{{Hash: ""}}.Find(&vulnerability.Vulnerability{}, ""))
+	})
+
+	t.Run("Should return risk acceptances expiring within days", func(t *testing.T) {
+		assert.Equal(t, RiskAcceptances{acceptances[2], acceptances[3]}, acceptances.ExpiringWithin(now, 7))
+		assert.Equal(t, RiskAcceptances{acceptances[2]}, acceptances.ExpiringWithin(now, 1))
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulesconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+// RulesConfig is a map of a rule ID to its config. The rule ID can be of a Horusec engine
+// rule, e.g. HS-JAVA-1, or of a rule from a tool, e.g. G104 from GoSec.
+//
+// Note that the rule IDs are compared without case sensitivity, since the keys of config
+// file are always read in lower case.
+type RulesConfig map[string]Config
+
+// Config represents the configuration options of a rule.
+//
+// Severity and Confidence are optional and when set override the ones from the rule.
+type Config struct {
+	Disabled   bool   `json:"disabled"`
+	Severity   string `json:"severity"`
+	Confidence string `json:"confidence"`
+}
+
+// MustParseRulesConfig parse input, that can be a JSON string or the value read
+// from config file, to RulesConfig.
+//
+// If some error occur an empty config will be returned and the error will be logged.
+func MustParseRulesConfig(input interface{}) RulesConfig {
+	cfg, err := parseRulesConfig(input)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorParseRulesConfig, err)
+		return RulesConfig{}
+	}
+
+	return cfg
+}
+
+func parseRulesConfig(input interface{}) (cfg RulesConfig, err error) {
+	content, isString := input.(string)
+	bytes := []byte(content)
+
+	if !isString {
+		if bytes, err = json.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(bytes, &cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Get return the config of the rule with ruleID.
+func (r RulesConfig) Get(ruleID string) (Config, bool) {
+	if cfg, exists := r[ruleID]; exists {
+		return cfg, true
+	}
+
+	for id, cfg := range r {
+		if strings.EqualFold(id, ruleID) {
+			return cfg, true
+		}
+	}
+
+	return Config{}, false
+}
+
+// IsDisabled return true if the rule with ruleID is disabled.
+func (r RulesConfig) IsDisabled(ruleID string) bool {
+	cfg, exists := r.Get(ruleID)
+	return exists && cfg.Disabled
+}
+
+// Apply override the severity and confidence of vuln with the config of its rule.
+// False is returned if the rule of vuln is disabled, so the vulnerability should be ignored.
+func (r RulesConfig) Apply(vuln *vulnerability.Vulnerability) bool {
+	cfg, exists := r.Get(vuln.RuleID)
+	if !exists || vuln.RuleID == "" {
+		return true
+	}
+
+	if cfg.Disabled {
+		return false
+	}
+
+	if cfg.Severity != "" {
+		vuln.Severity = severities.GetSeverityByString(strings.ToUpper(cfg.Severity))
+	}
+
+	if cfg.Confidence != "" {
+		vuln.Confidence = confidence.Confidence(strings.ToUpper(cfg.Confidence))
+	}
+
+	return true
+}
+
+// Validate return an error if some rule has an invalid severity or confidence.
+func (r RulesConfig) Validate() error {
+	for id, cfg := range r {
+		if (cfg.Severity != "" && !severities.Contains(cfg.Severity)) ||
+			(cfg.Confidence != "" && !isConfidenceValid(cfg.Confidence)) {
+			return fmt.Errorf("%s%s", messages.MsgErrorInvalidRuleConfig, id)
+		}
+	}
+
+	return nil
+}
+
+func isConfidenceValid(value string) bool {
+	for _, item := range confidence.Values() {
+		if strings.EqualFold(item.ToString(), value) {
+			return true
+		}
+	}
+
+	return false
+}
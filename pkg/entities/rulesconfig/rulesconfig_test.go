@@ -0,0 +1,85 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulesconfig
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+func TestMustParseRulesConfig(t *testing.T) {
+	expected := RulesConfig{
+		"G104":      {Disabled: true},
+		"HS-JAVA-1": {Severity: "LOW", Confidence: "HIGH"},
+	}
+
+	t.Run("Should parse rules config from JSON string", func(t *testing.T) {
+		cfg := MustParseRulesConfig(
+			`{"G104": {"disabled": true}, "HS-JAVA-1": {"severity": "LOW", "confidence": "HIGH"}}`,
+		)
+		assert.Equal(t, expected, cfg)
+	})
+
+	t.Run("Should parse rules config from config file values", func(t *testing.T) {
+		cfg := MustParseRulesConfig(map[string]interface{}{
+			"G104":      map[string]interface{}{"disabled": true},
+			"HS-JAVA-1": map[string]interface{}{"severity": "LOW", "confidence": "HIGH"},
+		})
+		assert.Equal(t, expected, cfg)
+	})
+
+	t.Run("Should return empty config when input is invalid", func(t *testing.T) {
+		assert.Empty(t, MustParseRulesConfig("invalid"))
+	})
+}
+
+func TestRulesConfig(t *testing.T) {
+	cfg := RulesConfig{
+		"g104":      {Disabled: true},
+		"HS-JAVA-1": {Severity: "low", Confidence: "high"},
+	}
+
+	t.Run("Should return if rule is disabled ignoring case", func(t *testing.T) {
+		assert.True(t, cfg.IsDisabled("G104"))
+		assert.False(t, cfg.IsDisabled("hs-java-1"))
+		assert.False(t, cfg.IsDisabled("HS-JAVA-2"))
+	})
+
+	t.Run("Should apply rule config on vulnerability", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{RuleID: "HS-JAVA-1", Severity: severities.High, Confidence: confidence.Low}
+		assert.True(t, cfg.Apply(vuln))
+		assert.Equal(t, severities.Low, vuln.Severity)
+		assert.Equal(t, confidence.High, vuln.Confidence)
+
+		vuln = &vulnerability.Vulnerability{RuleID: "HS-JAVA-2", Severity: severities.High, Confidence: confidence.Low}
+		assert.True(t, cfg.Apply(vuln))
+		assert.Equal(t, severities.High, vuln.Severity)
+		assert.Equal(t, confidence.Low, vuln.Confidence)
+
+		assert.False(t, cfg.Apply(&vulnerability.Vulnerability{RuleID: "G104"}))
+	})
+
+	t.Run("Should validate severity and confidence of rules", func(t *testing.T) {
+		assert.NoError(t, cfg.Validate())
+		assert.EqualError(t, RulesConfig{"G104": {Severity: "test"}}.Validate(), messages.MsgErrorInvalidRuleConfig+"G104")
+		assert.EqualError(t, RulesConfig{"G104": {Confidence: "test"}}.Validate(), messages.MsgErrorInvalidRuleConfig+"G104")
+	})
+}
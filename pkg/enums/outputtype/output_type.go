@@ -14,9 +14,28 @@
 
 package outputtype
 
+import "strings"
+
 const (
 	Text      = "text"
 	JSON      = "json"
 	Sarif     = "sarif"
 	SonarQube = "sonarqube"
+	HTML      = "html"
+	JUnit     = "junit"
+
+	GitLabSAST               = "gitlab-sast"
+	GitLabDependencyScanning = "gitlab-dependency-scanning"
+	CodeClimate              = "codeclimate"
 )
+
+// separator split the output type from the file path of an output, e.g. "sarif=out.sarif".
+const separator = "="
+
+// Parse return the output type and the file path of an output in the format "type=path".
+// The path is empty when the output doesn't set one, e.g. "text".
+func Parse(output string) (outputType, path string) {
+	outputType, path, _ = strings.Cut(output, separator)
+
+	return strings.TrimSpace(outputType), strings.TrimSpace(path)
+}
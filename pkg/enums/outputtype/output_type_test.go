@@ -25,3 +25,16 @@ func TestToString(t *testing.T) {
 		assert.Equal(t, "json", JSON)
 	})
 }
+
+func TestParse(t *testing.T) {
+	t.Run("Should return output type and path", func(t *testing.T) {
+		outputType, path := Parse("sarif= out.sarif")
+		assert.Equal(t, Sarif, outputType)
+		assert.Equal(t, "out.sarif", path)
+	})
+	t.Run("Should return empty path when output doesn't set one", func(t *testing.T) {
+		outputType, path := Parse("text")
+		assert.Equal(t, Text, outputType)
+		assert.Empty(t, path)
+	})
+}
@@ -33,4 +33,13 @@ const (
 	MsgDebugToolIgnored                  = "{HORUSEC_CLI} The tool was ignored for run in this analysis: "
 	MsgDebugVulnHashToFix                = "{HORUSEC_CLI} Vulnerability Hash expected to be FIXED: "
 	MsgDebugDockerImageDoesNotExists     = "{HORUSEC_CLI} Image %s does not exists. Pulling from registry"
+	MsgDebugManifestRenderFailed         = "{HORUSEC_CLI} Failed to render Kubernetes manifest, it will be analyzed as is: "
+	MsgDebugManifestResourceIgnored      = "{HORUSEC_CLI} Kustomize resource is not a local file or directory and was ignored: "
+	MsgDebugManifestHelmChartsIgnored    = "{HORUSEC_CLI} Kustomization helmCharts need the helm binary and were ignored: "
+	MsgDebugFileLinesNotRead             = "{HORUSEC_CLI} Failed to read lines of file: "
+	MsgDebugRuleVersion                  = "{HORUSEC_CLI} Failed to generate rule version, the cache could have outdated results: "
+	MsgDebugCacheWrite                   = "{HORUSEC_CLI} Failed to write engine results on cache: "
+	MsgDebugCacheDisabled                = "{HORUSEC_CLI} Engine results cache is not available: "
+	MsgDebugCacheEvict                   = "{HORUSEC_CLI} Failed to evict stale engine results from cache: "
+	MsgDebugRuleDisabled                 = "{HORUSEC_CLI} The rule was disabled on config: "
 )
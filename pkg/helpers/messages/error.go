@@ -21,6 +21,7 @@ const (
 	MsgErrorPathNotValid                        = "invalid path:"
 	MsgErrorJSONOutputFilePathNotValidExtension = "Output File path not valid file of type:"
 	MsgErrorJSONOutputFilePathNotValidUnknown   = "Output File path is required or is invalid:"
+	MsgErrorOutputTypeNotValid                  = "Output type not valid, it should be in the format type=path:"
 	MSgErrorGitLeaksPermissionDenied            = "error: could not lock config file .git/config: Permission denied"
 	MsgErrorSeverityNotValid                    = "Type of severity not valid. See severities enable:"
 	MsgErrorAskForUserCancelled                 = "{HORUSEC_CLI} Operation was canceled by user"
@@ -57,6 +58,7 @@ const (
 	MsgErrorDetectLanguage               = "{HORUSEC_CLI} Error when detect language"
 	MsgErrorCopyProjectToHorusecAnalysis = "{HORUSEC_CLI} Error when copy project to .horusec folder"
 	MsgErrorGenerateJSONFile             = "{HORUSEC_CLI} Error when try parse horusec analysis to output"
+	MsgErrorGenerateHTMLFile             = "{HORUSEC_CLI} Error when try render horusec analysis to HTML output"
 	MsgErrorDockerPullImage              = "{HORUSEC_CLI} Error when pull new image: "
 	MsgErrorDockerListImages             = "{HORUSEC_CLI} Error when list all images enable: "
 	MsgErrorDockerCreateContainer        = "{HORUSEC_CLI} Error when create container of analysis: "
@@ -65,6 +67,7 @@ const (
 	MsgErrorDockerRemoveContainer        = "{HORUSEC_CLI} Error when remove container of analysis: "
 	MsgErrorGitCommitAuthorsExecute      = "{HORUSEC_CLI} Error when execute commit author command: "
 	MsgErrorGitCommitAuthorsParseOutput  = "{HORUSEC_CLI} Error when to parse output to commit author struct: "
+	MsgErrorGitChangedFilesExecute       = "{HORUSEC_CLI} Error when execute git command to get the changed files: "
 	MsgErrorParseStringToWorkDir         = "{HORUSEC_CLI} Error when try parse workdir string to entity." +
 		"Returning default values"
 	MsgErrorDeferFileClose                   = "{HORUSEC_CLI} Error defer file close: "
@@ -79,4 +82,23 @@ const (
 	MsgErrorGetDependencyCodeFilepathAndLine = "{HORUSEC_CLI} Error when get dependency code filepath and line"
 	MsgErrorGetDependencyInfo                = "{HORUSEC_CLI} Error when get dependency code info"
 	MsgErrorBundlerNotAccessDB               = "{HORUSEC_CLI} BundlerAudit cannot access database in github: "
+	MsgErrorReadBaselineFile                 = "{HORUSEC_CLI} Error when read baseline file on path: "
+	MsgErrorReadAnalysisFile                 = "{HORUSEC_CLI} Error when read analysis JSON file on path: "
+	MsgErrorWriteBaselineFile                = "{HORUSEC_CLI} Error when write baseline file on path: "
+	MsgErrorWriteDiffOutput                  = "{HORUSEC_CLI} Error when write diff output"
+	MsgErrorInvalidDiffOutputFormat          = "{HORUSEC_CLI} Invalid diff output format, the valid formats are: "
+	MsgErrorInvalidFailOnSeverity            = "{HORUSEC_CLI} Invalid severity to fail on: "
+	MsgErrorInvalidFailOnSeverityType        = "{HORUSEC_CLI} Invalid vulnerability type to fail on severity: "
+	MsgErrorSetFailOnSeverityByTypeOnConfig  = "{HORUSEC_CLI} Error on set fail on severity by type on configurations"
+	MsgErrorReadPolicyFile                   = "{HORUSEC_CLI} Error when read policy file on path: "
+	MsgErrorInvalidPolicySeverity            = "{HORUSEC_CLI} Invalid severity on policy rule: "
+	MsgErrorInvalidPolicyGlob                = "{HORUSEC_CLI} Invalid glob pattern on policy path: "
+	MsgErrorInvalidPolicyRiskAcceptance      = "{HORUSEC_CLI} Invalid policy risk acceptance, the hash is required"
+	MsgErrorInvalidPolicyExpiresAt           = "{HORUSEC_CLI} Invalid expiration date on policy risk acceptance, use the format YYYY-MM-DD: "
+	MsgErrorPolicyRiskAcceptanceExpired      = "{HORUSEC_CLI} Policy violation: risk acceptance of vulnerability %s expired at %s (owner: %s, ticket: %s)"
+	MsgErrorPolicyMissingJustification       = "{HORUSEC_CLI} Policy violation: risk acceptance of vulnerability %s has no justification"
+	MsgErrorParseRiskAcceptances             = "{HORUSEC_CLI} Error when parse risk acceptances on configurations"
+	MsgErrorParseRulesConfig                 = "{HORUSEC_CLI} Error when parse rules on configurations"
+	MsgErrorInvalidRuleConfig                = "{HORUSEC_CLI} Invalid severity or confidence on rule config: "
+	MsgErrorInvalidRiskAcceptance            = "{HORUSEC_CLI} Invalid risk acceptance, the hash is required and the expiration date should use the format YYYY-MM-DD: "
 )
@@ -26,8 +26,16 @@ const (
 	`
 	MsgInfoStartGenerateSonarQubeFile = "{HORUSEC_CLI} Generating SonarQube output..."
 	MsgInfoStartGenerateSARIFFile     = "{HORUSEC_CLI} Generating SARIF output..."
+	MsgInfoStartGenerateHTMLFile      = "{HORUSEC_CLI} Generating HTML output..."
+	MsgInfoStartGenerateJUnitFile     = "{HORUSEC_CLI} Generating JUnit XML output..."
 	MsgInfoStartWriteFile             = "{HORUSEC_CLI} Writing output JSON to file in the path: "
 	MsgInfoAnalysisLoading            = " Scanning code ..."
 	MsgInfoDockerLowerVersion         = "{HORUSEC_CLI} We recommend version 19.03 or higher of the docker." +
 		" Versions prior to this may have problems during execution"
+	MsgInfoBaselineCreated           = "{HORUSEC_CLI} Baseline with %d vulnerabilities created on path: %s"
+	MsgInfoVulnerabilitiesOnBaseline = "{HORUSEC_CLI} %d vulnerabilities were found on baseline and set as risk accepted"
+
+	MsgInfoStartGenerateGitLabSASTFile  = "{HORUSEC_CLI} Generating GitLab SAST output..."
+	MsgInfoStartGenerateGitLabDSFile    = "{HORUSEC_CLI} Generating GitLab Dependency Scanning output..."
+	MsgInfoStartGenerateCodeClimateFile = "{HORUSEC_CLI} Generating CodeClimate output..."
 )
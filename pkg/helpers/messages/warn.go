@@ -58,4 +58,10 @@ const (
 	MsgWarnPathIsInvalidGitRepository    = "{HORUSEC_CLI} The current path it's not a valid git repository"
 	MsgWarnBrakemanNotRubyOnRailsProject = "brakeman only works on Ruby On Rails project"
 	MsgWarnGemfileIsRequiredForBundler   = "Gemfile.lock file is required to execute Bundler analysis"
+	MsgWarnRiskAcceptanceExpired         = "{HORUSEC_CLI} Risk acceptance of vulnerability %s expired at %s " +
+		"(owner: %s, ticket: %s), so it no longer accepts the vulnerability"
+	MsgWarnRiskAcceptanceExpiringSoon = "{HORUSEC_CLI} Risk acceptance of vulnerability %s will expire at %s " +
+		"(owner: %s, ticket: %s)"
+	MsgWarnOutputFormatMergedIntoOutputs = "{HORUSEC_CLI} The output format %q was set together with --output, " +
+		"so it was added to the outputs as %q"
 )
@@ -0,0 +1,279 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/cmd/app/version"
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+const (
+	// dirName is the name of the Horusec directory inside the user cache directory.
+	dirName = "horusec"
+
+	// maxAge is how long an entry is kept on cache without being used.
+	maxAge = 30 * 24 * time.Hour
+
+	dirPerm  = 0o750
+	filePerm = 0o600
+)
+
+// invalidDirNameChars match the characters of the Horusec version that can't be used on a directory name.
+var invalidDirNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// evictOnce ensure that stale entries of the default cache are evicted only once per run.
+var evictOnce sync.Once
+
+// Cache is an on-disk cache of Horusec engine findings. Each entry is keyed by the
+// SHA-256 of a file content and stored on the directory of the rule set used to analyze
+// it, so unchanged files don't need to be analyzed again by the same rules.
+//
+// The entries are stored as <dir>/<horusec version>/<rule set version>/<key>.json, so
+// the entries of other Horusec versions and the ones that weren't used on the last
+// days can be evicted by Evict.
+type Cache struct {
+	dir string
+}
+
+// New create a new cache that store its entries on dir.
+func New(dir string) *Cache {
+	return &Cache{
+		dir: dir,
+	}
+}
+
+// Default create a new cache on the user cache directory, e.g. ~/.cache/horusec. The
+// stale entries of the cache are evicted the first time it's called.
+func Default() (*Cache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := New(filepath.Join(dir, dirName))
+
+	evictOnce.Do(func() {
+		if err := cache.Evict(time.Now()); err != nil {
+			logger.LogDebugWithLevel(messages.MsgDebugCacheEvict, err)
+		}
+	})
+
+	return cache, nil
+}
+
+// Evict remove the entries generated by other Horusec versions, since their rule sets
+// will never be used again, and the entries that weren't used since maxAge before now.
+func (c *Cache) Evict(now time.Time) error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == versionDirName() {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(c.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return c.evictOlderThan(filepath.Join(c.dir, versionDirName()), now.Add(-maxAge))
+}
+
+// evictOlderThan remove the entries of dir that were last used before cutoff and the
+// directories that become empty after it.
+func (c *Cache) evictOlderThan(dir string, cutoff time.Time) error {
+	var dirs []string
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if entry.IsDir() {
+			dirs = append(dirs, path)
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		if info.ModTime().Before(cutoff) {
+			return os.Remove(path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Directories are walked before their content, so they are removed on the reverse
+	// order. Removing a directory that is not empty fails and it's just kept.
+	for index := len(dirs) - 1; index >= 0; index-- {
+		_ = os.Remove(dirs[index])
+	}
+
+	return nil
+}
+
+// Get return the findings cached on key and true, or false if there is no entry for key.
+func (c *Cache) Get(key string) ([]engine.Finding, bool) {
+	content, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	// The modification time of the entry is used as its last usage time by Evict.
+	now := time.Now()
+	_ = os.Chtimes(c.path(key), now, now)
+
+	var findings []engine.Finding
+	if err := json.Unmarshal(content, &findings); err != nil {
+		return nil, false
+	}
+
+	return findings, true
+}
+
+// Set store the findings on key. The entry is written on a temporary file and then
+// renamed, so concurrent reads never see a partially written entry. Entries are only
+// readable by the current user, since they contain the findings of the analyzed code.
+func (c *Cache) Set(key string, findings []engine.Finding) error {
+	content, err := json.Marshal(findings)
+	if err != nil {
+		return err
+	}
+
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+"-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	if err := tmp.Chmod(filePerm); err != nil {
+		return errors.Join(err, tmp.Close(), os.Remove(tmp.Name()))
+	}
+
+	if _, err := tmp.Write(content); err != nil {
+		return errors.Join(err, tmp.Close(), os.Remove(tmp.Name()))
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Join(err, os.Remove(tmp.Name()))
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// path return the path of the entry of key. Entries are split in directories by the
+// first characters of key to avoid directories with too many files.
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
+
+// versionDirName return the name of the directory of the entries of the current Horusec version.
+func versionDirName() string {
+	return invalidDirNameChars.ReplaceAllString(version.Version, "_")
+}
+
+// RuleVersion return the version of a rule, that changes when the Horusec version or the
+// rule change, invalidating the entries generated with the previous rule.
+func RuleVersion(rule engine.Rule) string {
+	content, err := json.Marshal(rule)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugRuleVersion, err)
+	}
+
+	return crypto.GenerateSHA256(version.Version, string(content))
+}
+
+// Rule create a new engine.Rule that run rule on a file, returning the cached findings
+// when the file was already analyzed by the same rule. Each rule has its own entries, so
+// the rules of a language are cached and executed independently of each other.
+func (c *Cache) Rule(rule engine.Rule) engine.Rule {
+	return &cachedRule{
+		cache: New(filepath.Join(c.dir, versionDirName(), RuleVersion(rule))),
+		rule:  rule,
+	}
+}
+
+type cachedRule struct {
+	cache *Cache
+	rule  engine.Rule
+}
+
+// Run return the cached findings of the file on path or run the rule on it and cache
+// its findings. The filename of findings is not cached, since the same content could be
+// on another path, like on the .horusec folder of other analysis.
+func (r *cachedRule) Run(path string) ([]engine.Finding, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return r.rule.Run(path)
+	}
+
+	key := crypto.GenerateSHA256(string(content))
+	if findings, ok := r.cache.Get(key); ok {
+		return r.setFilename(findings, path), nil
+	}
+
+	findings, err := r.rule.Run(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.cache.Set(key, r.setFilename(findings, "")); err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugCacheWrite, err)
+	}
+
+	return r.setFilename(findings, path), nil
+}
+
+func (r *cachedRule) setFilename(findings []engine.Finding, path string) []engine.Finding {
+	for index := range findings {
+		findings[index].SourceLocation.Filename = path
+	}
+
+	return findings
+}
@@ -0,0 +1,219 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ruleMock struct {
+	ID       string
+	err      error
+	executed int
+}
+
+func (r *ruleMock) Run(path string) ([]engine.Finding, error) {
+	r.executed++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return []engine.Finding{
+		{
+			ID:             r.ID,
+			SourceLocation: engine.Location{Filename: path, Line: 1, Column: 1},
+		},
+	}, nil
+}
+
+func TestCache(t *testing.T) {
+	t.Run("Should set and get findings", func(t *testing.T) {
+		c := New(t.TempDir())
+		findings := []engine.Finding{{ID: "HS-GO-1"}}
+
+		require.NoError(t, c.Set("0123456789abcdef", findings))
+
+		cached, ok := c.Get("0123456789abcdef")
+		assert.True(t, ok)
+		assert.Equal(t, findings, cached)
+	})
+
+	t.Run("Should return false when key is not cached", func(t *testing.T) {
+		_, ok := New(t.TempDir()).Get("0123456789abcdef")
+		assert.False(t, ok)
+	})
+
+	t.Run("Should write entries readable only by the current user", func(t *testing.T) {
+		dir := t.TempDir()
+		c := New(dir)
+
+		require.NoError(t, c.Set("0123456789abcdef", []engine.Finding{}))
+
+		info, err := os.Stat(c.path("0123456789abcdef"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		info, err = os.Stat(filepath.Join(dir, "01"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o750), info.Mode().Perm())
+	})
+
+	t.Run("Should create cache on user cache directory", func(t *testing.T) {
+		t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+		c, err := Default()
+		require.NoError(t, err)
+
+		dir, err := os.UserCacheDir()
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "horusec"), c.dir)
+	})
+}
+
+func TestRule(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	otherPath := filepath.Join(dir, "other", "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("package main"), os.ModePerm))
+	require.NoError(t, os.MkdirAll(filepath.Dir(otherPath), os.ModePerm))
+	require.NoError(t, os.WriteFile(otherPath, []byte("package main"), os.ModePerm))
+
+	t.Run("Should run rule only when file was not analyzed before", func(t *testing.T) {
+		c := New(t.TempDir())
+		rule := &ruleMock{ID: "HS-GO-1"}
+
+		findings, err := c.Rule(rule).Run(path)
+		require.NoError(t, err)
+		require.Len(t, findings, 1)
+		assert.Equal(t, path, findings[0].SourceLocation.Filename)
+
+		findings, err = c.Rule(rule).Run(otherPath)
+		require.NoError(t, err)
+		require.Len(t, findings, 1)
+		assert.Equal(t, otherPath, findings[0].SourceLocation.Filename)
+
+		assert.Equal(t, 1, rule.executed)
+	})
+
+	t.Run("Should run rule again when file content changes", func(t *testing.T) {
+		c := New(t.TempDir())
+		rule := &ruleMock{ID: "HS-GO-1"}
+		changedPath := filepath.Join(t.TempDir(), "main.go")
+
+		require.NoError(t, os.WriteFile(changedPath, []byte("package main"), os.ModePerm))
+		_, err := c.Rule(rule).Run(changedPath)
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(changedPath, []byte("package main\n"), os.ModePerm))
+		_, err = c.Rule(rule).Run(changedPath)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, rule.executed)
+	})
+
+	t.Run("Should cache each rule independently", func(t *testing.T) {
+		c := New(t.TempDir())
+		rule := &ruleMock{ID: "HS-GO-1"}
+		otherRule := &ruleMock{ID: "HS-GO-2"}
+
+		_, err := c.Rule(rule).Run(path)
+		require.NoError(t, err)
+
+		findings, err := c.Rule(otherRule).Run(path)
+		require.NoError(t, err)
+		require.Len(t, findings, 1)
+		assert.Equal(t, "HS-GO-2", findings[0].ID)
+
+		_, err = c.Rule(rule).Run(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, rule.executed)
+		assert.Equal(t, 1, otherRule.executed)
+	})
+
+	t.Run("Should not cache when rule return error", func(t *testing.T) {
+		cacheDir := t.TempDir()
+		c := New(cacheDir)
+		rule := &ruleMock{ID: "HS-GO-1", err: errors.New("test")}
+
+		_, err := c.Rule(rule).Run(path)
+		assert.Error(t, err)
+
+		entries, err := os.ReadDir(cacheDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestEvict(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Should remove entries of other Horusec versions", func(t *testing.T) {
+		dir := t.TempDir()
+		c := New(dir)
+		require.NoError(t, New(filepath.Join(dir, "v0.0.1", "rules")).Set("0123456789abcdef", []engine.Finding{}))
+		require.NoError(t, New(filepath.Join(dir, versionDirName(), "rules")).Set("0123456789abcdef", []engine.Finding{}))
+
+		require.NoError(t, c.Evict(now))
+
+		assert.NoDirExists(t, filepath.Join(dir, "v0.0.1"))
+		assert.DirExists(t, filepath.Join(dir, versionDirName(), "rules"))
+	})
+
+	t.Run("Should remove entries not used since max age", func(t *testing.T) {
+		dir := t.TempDir()
+		c := New(dir)
+		rules := New(filepath.Join(dir, versionDirName(), "rules"))
+		require.NoError(t, rules.Set("0123456789abcdef", []engine.Finding{}))
+		require.NoError(t, rules.Set("fedcba9876543210", []engine.Finding{}))
+
+		old := now.Add(-maxAge - time.Hour)
+		require.NoError(t, os.Chtimes(rules.path("0123456789abcdef"), old, old))
+
+		require.NoError(t, c.Evict(now))
+
+		assert.NoFileExists(t, rules.path("0123456789abcdef"))
+		assert.NoDirExists(t, filepath.Dir(rules.path("0123456789abcdef")))
+		assert.FileExists(t, rules.path("fedcba9876543210"))
+	})
+
+	t.Run("Should keep entries used since max age", func(t *testing.T) {
+		dir := t.TempDir()
+		c := New(dir)
+		rules := New(filepath.Join(dir, versionDirName(), "rules"))
+		require.NoError(t, rules.Set("0123456789abcdef", []engine.Finding{}))
+
+		old := now.Add(-maxAge - time.Hour)
+		require.NoError(t, os.Chtimes(rules.path("0123456789abcdef"), old, old))
+
+		_, ok := rules.Get("0123456789abcdef")
+		require.True(t, ok)
+		require.NoError(t, c.Evict(now))
+
+		assert.FileExists(t, rules.path("0123456789abcdef"))
+	})
+
+	t.Run("Should not return error when cache directory does not exist", func(t *testing.T) {
+		assert.NoError(t, New(filepath.Join(t.TempDir(), "horusec")).Evict(now))
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeclimate
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+	vulninfo "github.com/mosajjal/horusec/pkg/utils/vuln_info"
+)
+
+const (
+	issueType        = "issue"
+	categorySecurity = "Security"
+	engineName       = "horusec"
+	maxDescription   = 255
+)
+
+type CodeClimate struct {
+	analysis      *analysis.Analysis
+	fingerprinter *vulnhash.Fingerprinter
+}
+
+func NewCodeClimate(entity *analysis.Analysis, projectPath string) *CodeClimate {
+	return &CodeClimate{
+		analysis:      entity,
+		fingerprinter: vulnhash.NewFingerprinter(projectPath),
+	}
+}
+
+func (cc *CodeClimate) ConvertVulnerabilityToCodeClimate() Report {
+	report := Report{}
+	for index := range cc.analysis.AnalysisVulnerabilities {
+		vuln := cc.analysis.AnalysisVulnerabilities[index].Vulnerability
+
+		report = append(report, cc.newIssue(&vuln))
+	}
+
+	return report
+}
+
+func (cc *CodeClimate) newIssue(vuln *vulnerability.Vulnerability) Issue {
+	begin, end := vulninfo.LineRange(vuln.Line)
+
+	return Issue{
+		Type:        issueType,
+		CheckName:   vulninfo.RuleName(vuln),
+		Description: vulninfo.Title(vuln, maxDescription),
+		Content:     Content{Body: vuln.Details},
+		Categories:  []string{categorySecurity},
+		Location: Location{
+			Path:  vuln.File,
+			Lines: Lines{Begin: cc.shouldBeGreatherThanZero(begin), End: cc.shouldBeGreatherThanZero(end)},
+		},
+		Severity:    cc.convertHorusecSeverityToCodeClimate(vuln.Severity),
+		Fingerprint: cc.fingerprinter.Fingerprint(vuln),
+		EngineName:  engineName,
+	}
+}
+
+func (cc *CodeClimate) shouldBeGreatherThanZero(v int) int {
+	if v > 0 {
+		return v
+	}
+
+	return 1
+}
+
+func (cc *CodeClimate) convertHorusecSeverityToCodeClimate(severity severities.Severity) string {
+	if value, ok := cc.getCodeClimateSeverityMap()[severity]; ok {
+		return value
+	}
+
+	return "info"
+}
+
+func (cc *CodeClimate) getCodeClimateSeverityMap() map[severities.Severity]string {
+	return map[severities.Severity]string{
+		severities.Critical: "blocker",
+		severities.High:     "critical",
+		severities.Medium:   "major",
+		severities.Low:      "minor",
+		severities.Unknown:  "info",
+		severities.Info:     "info",
+	}
+}
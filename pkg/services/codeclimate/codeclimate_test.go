@@ -0,0 +1,121 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeclimate
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+func TestConvertVulnerabilityToCodeClimate(t *testing.T) {
+	t.Run("should convert vulnerabilities to codeclimate issues", func(t *testing.T) {
+		entity := &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						RuleID:       "HS-GO-3",
+						File:         "main.go",
+						Line:         "10",
+						Details:      "Weak Hashing Function\nMD5 is broken",
+						Severity:     severities.Critical,
+						SecurityTool: tools.HorusecEngine,
+						VulnHash:     "da9ca87f",
+					},
+				},
+			},
+		}
+
+		report := NewCodeClimate(entity, t.TempDir()).ConvertVulnerabilityToCodeClimate()
+
+		require.Len(t, report, 1)
+		assert.Equal(t, Issue{
+			Type:        "issue",
+			CheckName:   "HS-GO-3",
+			Description: "Weak Hashing Function",
+			Content:     Content{Body: "Weak Hashing Function\nMD5 is broken"},
+			Categories:  []string{"Security"},
+			Location:    Location{Path: "main.go", Lines: Lines{Begin: 10, End: 10}},
+			Severity:    "blocker",
+			Fingerprint: vulnhash.Fingerprint(&entity.AnalysisVulnerabilities[0].Vulnerability, nil),
+			EngineName:  "horusec",
+		}, report[0])
+	})
+
+	t.Run("should convert the range of lines of vulnerability", func(t *testing.T) {
+		entity := &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{Vulnerability: vulnerability.Vulnerability{File: "deployment.yaml", Line: "10-12"}},
+			},
+		}
+
+		report := NewCodeClimate(entity, t.TempDir()).ConvertVulnerabilityToCodeClimate()
+
+		require.Len(t, report, 1)
+		assert.Equal(t, Lines{Begin: 10, End: 12}, report[0].Location.Lines)
+	})
+
+	t.Run("should generate the same fingerprint when vulnerability is moved to another line", func(t *testing.T) {
+		vuln := vulnerability.Vulnerability{RuleID: "HS-GO-3", File: "main.go", Line: "10", Code: "md5.New()"}
+		moved := vuln
+		moved.Line = "20"
+		entity := &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{{Vulnerability: vuln}, {Vulnerability: moved}},
+		}
+
+		report := NewCodeClimate(entity, t.TempDir()).ConvertVulnerabilityToCodeClimate()
+
+		require.Len(t, report, 2)
+		assert.Equal(t, report[0].Fingerprint, report[1].Fingerprint)
+	})
+
+	t.Run("should generate a stable fingerprint without vulnerability hash", func(t *testing.T) {
+		entity := &analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						File:         "go.sum",
+						Severity:     severities.Low,
+						SecurityTool: tools.Nancy,
+					},
+				},
+			},
+		}
+
+		first := NewCodeClimate(entity, t.TempDir()).ConvertVulnerabilityToCodeClimate()
+		second := NewCodeClimate(entity, t.TempDir()).ConvertVulnerabilityToCodeClimate()
+
+		require.Len(t, first, 1)
+		assert.NotEmpty(t, first[0].Fingerprint)
+		assert.Equal(t, first[0].Fingerprint, second[0].Fingerprint)
+		assert.Equal(t, "Nancy", first[0].CheckName)
+		assert.Equal(t, "minor", first[0].Severity)
+		assert.Equal(t, Lines{Begin: 1, End: 1}, first[0].Location.Lines)
+	})
+
+	t.Run("issues should not be nil", func(t *testing.T) {
+		report := NewCodeClimate(&analysis.Analysis{}, "").ConvertVulnerabilityToCodeClimate()
+
+		assert.NotNil(t, report)
+		assert.Empty(t, report)
+	})
+}
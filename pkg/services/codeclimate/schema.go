@@ -0,0 +1,45 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeclimate
+
+// Report is the list of issues of the CodeClimate format, which is also used by the
+// GitLab Code Quality report.
+type Report []Issue
+
+type Issue struct {
+	Type        string   `json:"type"`
+	CheckName   string   `json:"check_name"`
+	Description string   `json:"description"`
+	Content     Content  `json:"content"`
+	Categories  []string `json:"categories"`
+	Location    Location `json:"location"`
+	Severity    string   `json:"severity"`
+	Fingerprint string   `json:"fingerprint"`
+	EngineName  string   `json:"engine_name"`
+}
+
+type Content struct {
+	Body string `json:"body"`
+}
+
+type Location struct {
+	Path  string `json:"path"`
+	Lines Lines  `json:"lines"`
+}
+
+type Lines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end"`
+}
@@ -30,12 +30,16 @@ import (
 
 	"github.com/mosajjal/horusec/pkg/services/engines/csharp"
 	"github.com/mosajjal/horusec/pkg/services/engines/dart"
+	"github.com/mosajjal/horusec/pkg/services/engines/hcl"
 	"github.com/mosajjal/horusec/pkg/services/engines/java"
 	"github.com/mosajjal/horusec/pkg/services/engines/javascript"
 	"github.com/mosajjal/horusec/pkg/services/engines/kotlin"
 	"github.com/mosajjal/horusec/pkg/services/engines/kubernetes"
 	"github.com/mosajjal/horusec/pkg/services/engines/leaks"
 	"github.com/mosajjal/horusec/pkg/services/engines/nginx"
+	"github.com/mosajjal/horusec/pkg/services/engines/php"
+	"github.com/mosajjal/horusec/pkg/services/engines/python"
+	"github.com/mosajjal/horusec/pkg/services/engines/ruby"
 )
 
 type CustomRule struct {
@@ -55,7 +59,8 @@ func (c *CustomRule) Validate() error {
 			language: c.Language,
 		}),
 		validation.Field(&c.Language, validation.Required, validation.In(languages.CSharp, languages.Dart, languages.Java,
-			languages.Kotlin, languages.Yaml, languages.Leaks, languages.Javascript, languages.Nginx)),
+			languages.Kotlin, languages.Yaml, languages.Leaks, languages.Javascript, languages.Nginx,
+			languages.Python, languages.Ruby, languages.PHP, languages.HCL)),
 		validation.Field(&c.Severity, validation.Required, validation.In(severities.Info, severities.Unknown,
 			severities.Low, severities.Medium, severities.High, severities.Critical)),
 		validation.Field(&c.Confidence, validation.Required, validation.In(confidence.Low,
@@ -142,6 +147,14 @@ func (r ruleIDValidator) Validate(value interface{}) error {
 		rules = javascript.Rules()
 	case languages.Nginx:
 		rules = nginx.Rules()
+	case languages.Python:
+		rules = python.Rules()
+	case languages.Ruby:
+		rules = ruby.Rules()
+	case languages.PHP:
+		rules = php.Rules()
+	case languages.HCL:
+		rules = hcl.Rules()
 	default:
 		return fmt.Errorf("unsupported language %s", r.language)
 	}
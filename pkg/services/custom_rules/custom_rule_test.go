@@ -91,14 +91,14 @@ func TestValidate(t *testing.T) {
 		{
 			name: "should return error when not supported language",
 			cr: CustomRule{
-				ID:          "HS-PYTHON-1",
+				ID:          "HS-ELIXIR-1",
 				Name:        "test",
 				Description: "test",
 				Severity:    severities.Low,
 				Confidence:  confidence.Low,
 				Type:        Regular,
 				Expressions: []string{""},
-				Language:    languages.Python,
+				Language:    languages.Elixir,
 			},
 			validate: func(err error) {
 				require.Error(t, err)
@@ -49,6 +49,10 @@ func NewCustomRulesService(cfg *config.Config) *Service {
 			languages.Leaks:      make([]engine.Rule, 0),
 			languages.Javascript: make([]engine.Rule, 0),
 			languages.Nginx:      make([]engine.Rule, 0),
+			languages.Python:     make([]engine.Rule, 0),
+			languages.Ruby:       make([]engine.Rule, 0),
+			languages.PHP:        make([]engine.Rule, 0),
+			languages.HCL:        make([]engine.Rule, 0),
 		},
 	}
 	return service.loadCustomRules()
@@ -46,6 +46,10 @@ func TestGetCustomRulesByTool(t *testing.T) {
 		assert.Len(t, service.Load(languages.Yaml), 1)
 		assert.Len(t, service.Load(languages.Leaks), 1)
 		assert.Len(t, service.Load(languages.Javascript), 1)
+		assert.Len(t, service.Load(languages.Python), 1)
+		assert.Len(t, service.Load(languages.Ruby), 1)
+		assert.Len(t, service.Load(languages.PHP), 1)
+		assert.Len(t, service.Load(languages.HCL), 1)
 	})
 
 	t.Run("should use empty rules for all languages when file does not exists", func(t *testing.T) {
@@ -0,0 +1,203 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	"github.com/mosajjal/horusec/pkg/utils/severity"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+// Result is the comparison between the vulnerabilities of an old and a new analysis.
+// Only vulnerabilities that are not false positive, risk accepted or corrected are compared.
+type Result struct {
+	// New are the vulnerabilities of the new analysis that are not on the old one.
+	New []vulnerability.Vulnerability `json:"new"`
+
+	// Fixed are the vulnerabilities of the old analysis that are not on the new one.
+	Fixed []vulnerability.Vulnerability `json:"fixed"`
+
+	// Unchanged are the vulnerabilities of the new analysis that are also on the old one.
+	Unchanged []vulnerability.Vulnerability `json:"unchanged"`
+}
+
+// Analysis is an analysis to compare and the fingerprints of its vulnerabilities written on
+// its JSON output, by index of AnalysisVulnerabilities.
+type Analysis struct {
+	*analysis.Analysis
+	fingerprints []string
+}
+
+// fingerprintsOutput is the part of the JSON output of an analysis with the fingerprints.
+type fingerprintsOutput struct {
+	AnalysisVulnerabilities []struct {
+		Vulnerability struct {
+			Fingerprint string `json:"fingerprint"`
+		} `json:"vulnerabilities"`
+	} `json:"analysisVulnerabilities"`
+}
+
+// ParseAnalysis parse the JSON output of an analysis, including the fingerprints of its
+// vulnerabilities when they were written by the Horusec version that generated the output.
+func ParseAnalysis(content []byte) (*Analysis, error) {
+	entity := new(analysis.Analysis)
+	if err := json.Unmarshal(content, entity); err != nil {
+		return nil, err
+	}
+
+	output := new(fingerprintsOutput)
+	if err := json.Unmarshal(content, output); err != nil {
+		return nil, err
+	}
+
+	fingerprints := make([]string, 0, len(output.AnalysisVulnerabilities))
+	for index := range output.AnalysisVulnerabilities {
+		fingerprints = append(fingerprints, output.AnalysisVulnerabilities[index].Vulnerability.Fingerprint)
+	}
+
+	return &Analysis{Analysis: entity, fingerprints: fingerprints}, nil
+}
+
+// Compare classify the vulnerabilities of old and new analysis as new, fixed and unchanged.
+// Vulnerabilities are matched by hash first and then by fingerprint, so vulnerabilities that
+// were only moved to other lines are unchanged. The fingerprints written on the analysis
+// output are used when available, otherwise the ones of the new analysis are generated by
+// fingerprinter from the project source. The source of the old analysis is usually not the
+// current one, so when its output has no fingerprints, like the ones written by older
+// versions, the vulnerabilities are matched only by hash.
+func Compare(oldAnalysis, newAnalysis *Analysis, fingerprinter *vulnhash.Fingerprinter) *Result {
+	oldVulns, oldFingerprints := oldAnalysis.activeVulnerabilities(nil)
+	newVulns, newFingerprints := newAnalysis.activeVulnerabilities(fingerprinter)
+
+	oldMatched := make([]bool, len(oldVulns))
+	newMatched := make([]bool, len(newVulns))
+
+	match(hashes(oldVulns), hashes(newVulns), oldMatched, newMatched)
+	if oldAnalysis.hasFingerprints() {
+		match(oldFingerprints, newFingerprints, oldMatched, newMatched)
+	}
+
+	result := &Result{
+		New:       make([]vulnerability.Vulnerability, 0),
+		Fixed:     make([]vulnerability.Vulnerability, 0),
+		Unchanged: make([]vulnerability.Vulnerability, 0),
+	}
+
+	for index := range newVulns {
+		if newMatched[index] {
+			result.Unchanged = append(result.Unchanged, newVulns[index])
+		} else {
+			result.New = append(result.New, newVulns[index])
+		}
+	}
+
+	for index := range oldVulns {
+		if !oldMatched[index] {
+			result.Fixed = append(result.Fixed, oldVulns[index])
+		}
+	}
+
+	return result
+}
+
+// NewWithSeverity return the new vulnerabilities with severity equal or higher than threshold.
+func (r *Result) NewWithSeverity(threshold severities.Severity) []vulnerability.Vulnerability {
+	var vulnerabilities []vulnerability.Vulnerability
+
+	for index := range r.New {
+		if severity.IsAtLeast(r.New[index].Severity, threshold) {
+			vulnerabilities = append(vulnerabilities, r.New[index])
+		}
+	}
+
+	return vulnerabilities
+}
+
+// match mark the old and new vulnerabilities not matched yet that have the same key. Each
+// vulnerability is matched only once, so duplicated vulnerabilities are compared by quantity.
+func match(oldKeys, newKeys []string, oldMatched, newMatched []bool) {
+	oldByKey := make(map[string][]int)
+	for index, key := range oldKeys {
+		if !oldMatched[index] {
+			oldByKey[key] = append(oldByKey[key], index)
+		}
+	}
+
+	for index, key := range newKeys {
+		if newMatched[index] {
+			continue
+		}
+
+		if candidates := oldByKey[key]; key != "" && len(candidates) > 0 {
+			oldMatched[candidates[0]] = true
+			newMatched[index] = true
+			oldByKey[key] = candidates[1:]
+		}
+	}
+}
+
+func hashes(vulnerabilities []vulnerability.Vulnerability) []string {
+	keys := make([]string, 0, len(vulnerabilities))
+	for index := range vulnerabilities {
+		keys = append(keys, vulnerabilities[index].VulnHash)
+	}
+
+	return keys
+}
+
+// activeVulnerabilities return the vulnerabilities that are not false positive, risk accepted
+// or corrected and their fingerprints.
+func (a *Analysis) activeVulnerabilities(
+	fingerprinter *vulnhash.Fingerprinter,
+) (vulnerabilities []vulnerability.Vulnerability, fingerprints []string) {
+	for index := range a.AnalysisVulnerabilities {
+		vuln := a.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.Type != "" && vuln.Type != enumsVulnerability.Vulnerability {
+			continue
+		}
+
+		vulnerabilities = append(vulnerabilities, vuln)
+		fingerprints = append(fingerprints, a.fingerprint(index, &vuln, fingerprinter))
+	}
+
+	return vulnerabilities, fingerprints
+}
+
+// hasFingerprints return true if the analysis output has the fingerprints of its vulnerabilities.
+func (a *Analysis) hasFingerprints() bool {
+	for _, fingerprint := range a.fingerprints {
+		if fingerprint != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Analysis) fingerprint(index int, vuln *vulnerability.Vulnerability,
+	fingerprinter *vulnhash.Fingerprinter,
+) string {
+	if index < len(a.fingerprints) && a.fingerprints[index] != "" {
+		return a.fingerprints[index]
+	}
+
+	return fingerprinter.Fingerprint(vuln)
+}
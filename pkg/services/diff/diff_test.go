@@ -0,0 +1,219 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosajjal/horusec/pkg/services/sarif"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+func TestCompare(t *testing.T) {
+	unchanged := newVulnerability("HS-GO-1", "main.go", "10", "exec.Command(input)", severities.High)
+	moved := newVulnerability("HS-GO-2", "main.go", "20", "md5.New()", severities.Medium)
+	fixed := newVulnerability("HS-GO-3", "main.go", "30", "sql.Query(input)", severities.Critical)
+	added := newVulnerability("HS-GO-4", "util.go", "5", "rand.Int()", severities.Low)
+	accepted := newVulnerability("HS-GO-5", "util.go", "8", "tls.Config{}", severities.High)
+	accepted.Type = enumsVulnerability.RiskAccepted
+
+	movedOnNew := newVulnerability("HS-GO-2", "main.go", "25", "md5.New()", severities.Medium)
+
+	oldAnalysis := newAnalysisWithFingerprints(unchanged, moved, fixed)
+	currentAnalysis := newAnalysis(unchanged, movedOnNew, added, accepted)
+
+	result := Compare(oldAnalysis, currentAnalysis, nil)
+
+	assert.Equal(t, []vulnerability.Vulnerability{added}, result.New)
+	assert.Equal(t, []vulnerability.Vulnerability{fixed}, result.Fixed)
+	assert.Equal(t, []vulnerability.Vulnerability{unchanged, movedOnNew}, result.Unchanged)
+
+	t.Run("Should compare duplicated vulnerabilities by quantity", func(t *testing.T) {
+		result := Compare(newAnalysis(unchanged), newAnalysis(unchanged, unchanged), nil)
+
+		assert.Len(t, result.New, 1)
+		assert.Len(t, result.Unchanged, 1)
+		assert.Empty(t, result.Fixed)
+	})
+
+	t.Run("Should match only by hash when the old analysis has no fingerprints", func(t *testing.T) {
+		result := Compare(newAnalysis(unchanged, moved), newAnalysis(unchanged, movedOnNew), nil)
+
+		assert.Equal(t, []vulnerability.Vulnerability{unchanged}, result.Unchanged)
+		assert.Equal(t, []vulnerability.Vulnerability{movedOnNew}, result.New)
+		assert.Equal(t, []vulnerability.Vulnerability{moved}, result.Fixed)
+	})
+
+	t.Run("Should return new vulnerabilities with severity equal or higher than threshold", func(t *testing.T) {
+		assert.Empty(t, result.NewWithSeverity(severities.Medium))
+		assert.Equal(t, []vulnerability.Vulnerability{added}, result.NewWithSeverity(severities.Low))
+	})
+}
+
+func TestCompareWithProjectSource(t *testing.T) {
+	oldSource := make([]string, 0, 40)
+	for line := 1; line <= 40; line++ {
+		oldSource = append(oldSource, fmt.Sprintf("line%d()", line))
+	}
+	oldSource[19] = "md5.New()"
+
+	// The new source has five lines added before the vulnerability, so it is moved to line 25.
+	newSource := append([]string{"a()", "b()", "c()", "d()", "e()"}, oldSource...)
+
+	projectPath := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(projectPath, "main.go"), []byte(strings.Join(newSource, "\n")), 0o600,
+	))
+
+	moved := newVulnerability("HS-GO-2", "main.go", "20", "md5.New()", severities.Medium)
+	movedOnNew := newVulnerability("HS-GO-2", "main.go", "25", "md5.New()", severities.Medium)
+
+	t.Run("Should match moved vulnerabilities by the fingerprint written on the old analysis", func(t *testing.T) {
+		oldAnalysis := newAnalysis(moved)
+		oldAnalysis.fingerprints = []string{vulnhash.Fingerprint(&moved, oldSource)}
+
+		result := Compare(oldAnalysis, newAnalysis(movedOnNew), vulnhash.NewFingerprinter(projectPath))
+
+		assert.Equal(t, []vulnerability.Vulnerability{movedOnNew}, result.Unchanged)
+		assert.Empty(t, result.New)
+		assert.Empty(t, result.Fixed)
+	})
+
+	t.Run("Should generate fingerprints with the context window of the project source", func(t *testing.T) {
+		oldAnalysis := newAnalysisWithFingerprints(moved)
+
+		result := Compare(oldAnalysis, newAnalysis(movedOnNew), vulnhash.NewFingerprinter(projectPath))
+
+		assert.Equal(t, []vulnerability.Vulnerability{movedOnNew}, result.New)
+		assert.Equal(t, []vulnerability.Vulnerability{moved}, result.Fixed)
+	})
+}
+
+func TestParseAnalysis(t *testing.T) {
+	t.Run("Should parse analysis and the fingerprints of its vulnerabilities", func(t *testing.T) {
+		entity, err := ParseAnalysis([]byte(`{"analysisVulnerabilities": [
+			{"vulnerabilities": {"rule_id": "HS-GO-1", "fingerprint": "abc"}},
+			{"vulnerabilities": {"rule_id": "HS-GO-2"}}
+		]}`))
+		require.NoError(t, err)
+
+		require.Len(t, entity.AnalysisVulnerabilities, 2)
+		assert.Equal(t, "HS-GO-1", entity.AnalysisVulnerabilities[0].Vulnerability.RuleID)
+		assert.Equal(t, []string{"abc", ""}, entity.fingerprints)
+	})
+
+	t.Run("Should return error when content is not a valid JSON", func(t *testing.T) {
+		_, err := ParseAnalysis([]byte("invalid"))
+		assert.Error(t, err)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	result := Compare(
+		newAnalysis(newVulnerability("HS-GO-3", "main.go", "30", "sql.Query(input)", severities.Critical)),
+		newAnalysis(newVulnerability("HS-GO-4", "util.go", "5", "a | b", severities.Low)),
+		nil,
+	)
+
+	t.Run("Should write text output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		require.NoError(t, result.Write(out, OutputText, "v2.9.0", "."))
+
+		assert.Contains(t, out.String(), "Vulnerabilities: 1 new, 1 fixed, 0 unchanged")
+		assert.Contains(t, out.String(), "[LOW] HS-GO-4 util.go:5")
+		assert.Contains(t, out.String(), "[CRITICAL] HS-GO-3 main.go:30")
+	})
+
+	t.Run("Should write JSON output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		require.NoError(t, result.Write(out, OutputJSON, "v2.9.0", "."))
+
+		var output resultOutputJSON
+		require.NoError(t, json.Unmarshal(out.Bytes(), &output))
+		assert.Equal(t, "v2.9.0", output.Version)
+		assert.Equal(t, summary{New: 1, Fixed: 1, Unchanged: 0}, output.Summary)
+		assert.Equal(t, result.New, output.New)
+	})
+
+	t.Run("Should write SARIF output only with new vulnerabilities", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		require.NoError(t, result.Write(out, OutputSarif, "v2.9.0", t.TempDir()))
+
+		var report sarif.Report
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		require.Len(t, report.Runs, 1)
+		require.Len(t, report.Runs[0].Results, 1)
+		assert.Equal(t, "HS-GO-4", report.Runs[0].Results[0].RuleID)
+	})
+
+	t.Run("Should write Markdown output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		require.NoError(t, result.Write(out, OutputMarkdown, "v2.9.0", "."))
+
+		assert.Contains(t, out.String(), "| 1 | 1 | 0 |")
+		assert.Contains(t, out.String(), "### New vulnerabilities")
+		assert.Contains(t, out.String(), "| LOW | HS-GO-4 | util.go | 5 | HorusecEngine | `a \\| b` |")
+		assert.Contains(t, out.String(), "### Fixed vulnerabilities")
+	})
+}
+
+func newVulnerability(ruleID, file, line, code string, severity severities.Severity) vulnerability.Vulnerability {
+	vuln := vulnerability.Vulnerability{
+		RuleID:       ruleID,
+		File:         file,
+		Line:         line,
+		Code:         code,
+		Severity:     severity,
+		SecurityTool: "HorusecEngine",
+		Type:         enumsVulnerability.Vulnerability,
+	}
+
+	return *vulnhash.Bind(&vuln)
+}
+
+// newAnalysisWithFingerprints create an analysis with the fingerprints of its vulnerabilities
+// generated without source, as written by an analysis without the project files.
+func newAnalysisWithFingerprints(vulns ...vulnerability.Vulnerability) *Analysis {
+	entity := newAnalysis(vulns...)
+	for index := range vulns {
+		entity.fingerprints = append(entity.fingerprints, vulnhash.Fingerprint(&vulns[index], nil))
+	}
+
+	return entity
+}
+
+func newAnalysis(vulns ...vulnerability.Vulnerability) *Analysis {
+	entity := new(analysis.Analysis)
+	for _, vuln := range vulns {
+		entity.AnalysisVulnerabilities = append(entity.AnalysisVulnerabilities, analysis.AnalysisVulnerabilities{
+			Vulnerability: vuln,
+		})
+	}
+
+	return &Analysis{Analysis: entity}
+}
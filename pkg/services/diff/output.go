@@ -0,0 +1,190 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+
+	"github.com/mosajjal/horusec/pkg/services/sarif"
+)
+
+// Output formats of a diff result.
+const (
+	OutputText     = "text"
+	OutputJSON     = "json"
+	OutputSarif    = "sarif"
+	OutputMarkdown = "markdown"
+)
+
+const separator = "\n==================================================================================\n"
+
+// Formats return all valid output formats of a diff result.
+func Formats() []string {
+	return []string{OutputText, OutputJSON, OutputSarif, OutputMarkdown}
+}
+
+type resultOutputJSON struct {
+	Version   string                        `json:"version"`
+	Summary   summary                       `json:"summary"`
+	New       []vulnerability.Vulnerability `json:"new"`
+	Fixed     []vulnerability.Vulnerability `json:"fixed"`
+	Unchanged []vulnerability.Vulnerability `json:"unchanged"`
+}
+
+type summary struct {
+	New       int `json:"new"`
+	Fixed     int `json:"fixed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// Write write the result on writer using the output format. The SARIF output contains only
+// the new vulnerabilities, since it's used to report the vulnerabilities introduced by a change.
+func (r *Result) Write(writer io.Writer, format, version, projectPath string) error {
+	switch format {
+	case OutputJSON:
+		return r.writeJSON(writer, version)
+	case OutputSarif:
+		return r.writeSarif(writer, projectPath)
+	case OutputMarkdown:
+		return r.writeMarkdown(writer)
+	default:
+		return r.writeText(writer)
+	}
+}
+
+func (r *Result) getSummary() summary {
+	return summary{
+		New:       len(r.New),
+		Fixed:     len(r.Fixed),
+		Unchanged: len(r.Unchanged),
+	}
+}
+
+func (r *Result) writeJSON(writer io.Writer, version string) error {
+	content, err := json.MarshalIndent(resultOutputJSON{
+		Version:   version,
+		Summary:   r.getSummary(),
+		New:       r.New,
+		Fixed:     r.Fixed,
+		Unchanged: r.Unchanged,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(writer, string(content))
+	return err
+}
+
+func (r *Result) writeSarif(writer io.Writer, projectPath string) error {
+	entity := &analysis.Analysis{}
+	for index := range r.New {
+		entity.AnalysisVulnerabilities = append(entity.AnalysisVulnerabilities, analysis.AnalysisVulnerabilities{
+			Vulnerability: r.New[index],
+		})
+	}
+
+	content, err := json.MarshalIndent(sarif.NewSarif(entity, projectPath).ConvertVulnerabilityToSarif(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(writer, string(content))
+	return err
+}
+
+func (r *Result) writeText(writer io.Writer) error {
+	out := new(strings.Builder)
+
+	out.WriteString(separator)
+	fmt.Fprintf(out, "Vulnerabilities: %d new, %d fixed, %d unchanged\n",
+		len(r.New), len(r.Fixed), len(r.Unchanged))
+	out.WriteString(separator)
+
+	r.writeTextVulnerabilities(out, "New", r.New)
+	r.writeTextVulnerabilities(out, "Fixed", r.Fixed)
+
+	_, err := io.WriteString(writer, out.String())
+	return err
+}
+
+func (r *Result) writeTextVulnerabilities(out *strings.Builder, title string, vulns []vulnerability.Vulnerability) {
+	if len(vulns) == 0 {
+		return
+	}
+
+	fmt.Fprintf(out, "%s vulnerabilities:\n", title)
+	for index := range vulns {
+		fmt.Fprintf(out, "\n[%s] %s %s:%s (%s)\n", vulns[index].Severity, ruleID(&vulns[index]),
+			vulns[index].File, vulns[index].Line, vulns[index].SecurityTool)
+		fmt.Fprintf(out, "Code: %s\n", vulns[index].Code)
+		fmt.Fprintf(out, "ReferenceHash: %s\n", vulns[index].VulnHash)
+	}
+	out.WriteString(separator)
+}
+
+func (r *Result) writeMarkdown(writer io.Writer) error {
+	out := new(strings.Builder)
+
+	out.WriteString("## Horusec diff\n\n")
+	out.WriteString("| New | Fixed | Unchanged |\n|---|---|---|\n")
+	fmt.Fprintf(out, "| %d | %d | %d |\n", len(r.New), len(r.Fixed), len(r.Unchanged))
+
+	r.writeMarkdownVulnerabilities(out, "New vulnerabilities", r.New)
+	r.writeMarkdownVulnerabilities(out, "Fixed vulnerabilities", r.Fixed)
+
+	_, err := io.WriteString(writer, out.String())
+	return err
+}
+
+func (r *Result) writeMarkdownVulnerabilities(out *strings.Builder, title string,
+	vulns []vulnerability.Vulnerability,
+) {
+	if len(vulns) == 0 {
+		return
+	}
+
+	fmt.Fprintf(out, "\n### %s\n\n", title)
+	out.WriteString("| Severity | Rule | File | Line | Tool | Code |\n|---|---|---|---|---|---|\n")
+	for index := range vulns {
+		fmt.Fprintf(out, "| %s | %s | %s | %s | %s | `%s` |\n",
+			vulns[index].Severity, markdownEscape(ruleID(&vulns[index])), markdownEscape(vulns[index].File),
+			vulns[index].Line, vulns[index].SecurityTool, markdownEscape(toOneLine(vulns[index].Code)))
+	}
+}
+
+// ruleID return the rule id of the vulnerability or the first line of its details,
+// since vulnerabilities of some tools don't have a rule id.
+func ruleID(vuln *vulnerability.Vulnerability) string {
+	if vuln.RuleID != "" {
+		return vuln.RuleID
+	}
+
+	return strings.Split(vuln.Details, "\n")[0]
+}
+
+func toOneLine(code string) string {
+	return strings.Join(strings.Fields(code), " ")
+}
+
+func markdownEscape(text string) string {
+	return strings.NewReplacer("|", `\|`, "`", "'").Replace(text)
+}
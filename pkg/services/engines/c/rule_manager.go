@@ -0,0 +1,44 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package c
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/services/engines"
+)
+
+func NewRules() *engines.RuleManager {
+	return engines.NewRuleManager(Rules(), extensions())
+}
+
+func extensions() []string {
+	return []string{".c", ".h", ".cpp", ".hpp", ".cc"}
+}
+
+// Rules return all rules registred to C engine.
+func Rules() []engine.Rule {
+	return []engine.Rule{
+		// Or rules
+		NewFormatStringWithNonLiteral(),
+		NewInsecureRandomForCryptography(),
+
+		// Regular rules
+		NewDangerousGetsFunction(),
+		NewBufferOverflowUnboundedCopy(),
+		NewOSCommandInjection(),
+		NewUncheckedMemoryAllocation(),
+	}
+}
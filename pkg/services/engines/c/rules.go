@@ -0,0 +1,136 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package c
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewDangerousGetsFunction() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-C-1",
+			Name:          "Dangerous Function gets",
+			Description:   "The gets function reads a line into a buffer without any way to limit its size, so any input longer than the buffer overflows it. It was removed from the C11 standard. Use fgets with the size of the buffer instead. For more information checkout the CWE-242 (https://cwe.mitre.org/data/definitions/242.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSC1,
+			UnsafeExample: SampleVulnerableHSC1,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bgets\s*\(`),
+		},
+	}
+}
+
+func NewBufferOverflowUnboundedCopy() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-C-2",
+			Name:          "Buffer Overflow",
+			Description:   "The strcpy, strcat, sprintf and vsprintf functions do not check the size of the destination buffer, so a source longer than the destination overflows it. Use bounded alternatives such as strncpy, strlcpy, strncat or snprintf. For more information checkout the CWE-120 (https://cwe.mitre.org/data/definitions/120.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSC2,
+			UnsafeExample: SampleVulnerableHSC2,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:strcpy|strcat|sprintf|vsprintf|wcscpy|wcscat|stpcpy)\s*\(`),
+		},
+	}
+}
+
+func NewFormatStringWithNonLiteral() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-C-3",
+			Name:          "Format String",
+			Description:   "Passing a value that is not a string literal as the format argument of printf like functions allows an attacker that controls it to read or write arbitrary memory using format specifiers such as %x and %n. Always use a constant format, e.g. printf(\"%s\", input). For more information checkout the CWE-134 (https://cwe.mitre.org/data/definitions/134.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSC3,
+			UnsafeExample: SampleVulnerableHSC3,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:printf|vprintf)\s*\(\s*[a-zA-Z_][\w\->.\[\]]*\s*\)`),
+			regexp.MustCompile(`\b(?:fprintf|vfprintf|dprintf|syslog)\s*\(\s*[^,;\n]+,\s*[a-zA-Z_][\w\->.\[\]]*\s*\)`),
+			regexp.MustCompile(`\bsnprintf\s*\(\s*[^,;\n]+,\s*[^,;\n]+,\s*[a-zA-Z_][\w\->.\[\]]*\s*\)`),
+		},
+	}
+}
+
+func NewOSCommandInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-C-4",
+			Name:          "OS Command Injection",
+			Description:   "The system and popen functions run their argument through the shell. When the command is built from values that are not constant an attacker can inject additional commands. Use the exec family with a fixed program and separate arguments instead. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSC4,
+			UnsafeExample: SampleVulnerableHSC4,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:system|popen)\s*\(\s*[^"\s)]`),
+		},
+	}
+}
+
+func NewInsecureRandomForCryptography() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-C-5",
+			Name:          "Insecure Random Number Generator",
+			Description:   "The rand family of functions is predictable, especially when seeded with the current time or process id, and must not be used to generate keys, tokens, salts or nonces. Use a cryptographically secure source such as getrandom, /dev/urandom or RAND_bytes. For more information checkout the CWE-338 (https://cwe.mitre.org/data/definitions/338.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSC5,
+			UnsafeExample: SampleVulnerableHSC5,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)\w*(?:key|token|salt|nonce|iv|password|secret|session)\w*(?:\[[^\]]*\])?\s*(?:=|\+=|\^=)[^;\n]*\b(?:rand|random|drand48|lrand48)\s*\(`),
+			regexp.MustCompile(`\bsrand\s*\(\s*(?:\(\w+\)\s*)?(?:time|getpid)\s*\(`),
+		},
+	}
+}
+
+func NewUncheckedMemoryAllocation() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-C-6",
+			Name:          "Unchecked Memory Allocation",
+			Description:   "The memory returned by malloc, calloc and realloc is used without checking whether the allocation failed. Dereferencing the NULL pointer returned on failure crashes the program and may be exploitable. Always compare the result against NULL before using it. For more information checkout the CWE-690 (https://cwe.mitre.org/data/definitions/690.html) advisory.",
+			Severity:      severities.Low.ToString(),
+			Confidence:    confidence.Low.ToString(),
+			SafeExample:   SampleSafeHSC6,
+			UnsafeExample: SampleVulnerableHSC6,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`=\s*(?:\([^)]*\)\s*)?(?:malloc|calloc|realloc)\s*\([^;]*\);[ \t]*\r?\n[ \t]*(?:memcpy|memmove|memset|strcpy|strncpy|strcat|strncat|sprintf|snprintf|\w+\s*(?:\[[^\]]*\]|->\w+)\s*=[^=])`),
+		},
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package c
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/utils/testutil"
+)
+
+func TestRulesVulnerableCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-C-3",
+			Rule:     NewFormatStringWithNonLiteral(),
+			Src:      SampleVulnerableHSC3,
+			Filename: filepath.Join(tempDir, "HS-C-3.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "printf(message);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-3.test"),
+						Line:     5,
+						Column:   4,
+					},
+				},
+				{
+					CodeSample: "fprintf(stderr, message);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-3.test"),
+						Line:     6,
+						Column:   4,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-C-5",
+			Rule:     NewInsecureRandomForCryptography(),
+			Src:      SampleVulnerableHSC5,
+			Filename: filepath.Join(tempDir, "HS-C-5.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "key[i] = rand() % 256;",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-5.test"),
+						Line:     8,
+						Column:   8,
+					},
+				},
+				{
+					CodeSample: "srand(time(NULL));",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-5.test"),
+						Line:     6,
+						Column:   4,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-C-1",
+			Rule:     NewDangerousGetsFunction(),
+			Src:      SampleVulnerableHSC1,
+			Filename: filepath.Join(tempDir, "HS-C-1.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "gets(name);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-1.test"),
+						Line:     6,
+						Column:   4,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-C-2",
+			Rule:     NewBufferOverflowUnboundedCopy(),
+			Src:      SampleVulnerableHSC2,
+			Filename: filepath.Join(tempDir, "HS-C-2.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "strcpy(buffer, input);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-2.test"),
+						Line:     6,
+						Column:   4,
+					},
+				},
+				{
+					CodeSample: `sprintf(buffer, "user: %s", input);`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-2.test"),
+						Line:     7,
+						Column:   4,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-C-4",
+			Rule:     NewOSCommandInjection(),
+			Src:      SampleVulnerableHSC4,
+			Filename: filepath.Join(tempDir, "HS-C-4.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "system(command);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-4.test"),
+						Line:     8,
+						Column:   4,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-C-6",
+			Rule:     NewUncheckedMemoryAllocation(),
+			Src:      SampleVulnerableHSC6,
+			Filename: filepath.Join(tempDir, "HS-C-6.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "char *copy = malloc(len + 1);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-C-6.test"),
+						Line:     6,
+						Column:   15,
+					},
+				},
+			},
+		},
+	}
+
+	testutil.TestVulnerableCode(t, testcases)
+}
+
+func TestRulesSafeCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-C-3",
+			Rule:     NewFormatStringWithNonLiteral(),
+			Src:      SampleSafeHSC3,
+			Filename: filepath.Join(tempDir, "HS-C-3.test"),
+		},
+		{
+			Name:     "HS-C-5",
+			Rule:     NewInsecureRandomForCryptography(),
+			Src:      SampleSafeHSC5,
+			Filename: filepath.Join(tempDir, "HS-C-5.test"),
+		},
+		{
+			Name:     "HS-C-1",
+			Rule:     NewDangerousGetsFunction(),
+			Src:      SampleSafeHSC1,
+			Filename: filepath.Join(tempDir, "HS-C-1.test"),
+		},
+		{
+			Name:     "HS-C-2",
+			Rule:     NewBufferOverflowUnboundedCopy(),
+			Src:      SampleSafeHSC2,
+			Filename: filepath.Join(tempDir, "HS-C-2.test"),
+		},
+		{
+			Name:     "HS-C-4",
+			Rule:     NewOSCommandInjection(),
+			Src:      SampleSafeHSC4,
+			Filename: filepath.Join(tempDir, "HS-C-4.test"),
+		},
+		{
+			Name:     "HS-C-6",
+			Rule:     NewUncheckedMemoryAllocation(),
+			Src:      SampleSafeHSC6,
+			Filename: filepath.Join(tempDir, "HS-C-6.test"),
+		},
+	}
+
+	testutil.TestSafeCode(t, testcases)
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package c
+
+const (
+	SampleVulnerableHSC1 = `
+#include <stdio.h>
+
+int main(void) {
+    char name[32];
+    gets(name);
+    printf("Hello %s\n", name);
+    return 0;
+}
+`
+	SampleSafeHSC1 = `
+#include <stdio.h>
+
+int main(void) {
+    char name[32];
+    fgets(name, sizeof(name), stdin);
+    printf("Hello %s\n", name);
+    return 0;
+}
+`
+
+	SampleVulnerableHSC2 = `
+#include <string.h>
+
+void copy_name(const char *input) {
+    char buffer[16];
+    strcpy(buffer, input);
+    sprintf(buffer, "user: %s", input);
+}
+`
+	SampleSafeHSC2 = `
+#include <string.h>
+
+void copy_name(const char *input) {
+    char buffer[16];
+    strncpy(buffer, input, sizeof(buffer) - 1);
+    snprintf(buffer, sizeof(buffer), "user: %s", input);
+}
+`
+
+	SampleVulnerableHSC3 = `
+#include <stdio.h>
+
+void log_message(const char *message) {
+    printf(message);
+    fprintf(stderr, message);
+}
+`
+	SampleSafeHSC3 = `
+#include <stdio.h>
+
+void log_message(const char *message) {
+    printf("%s", message);
+    fprintf(stderr, "%s\n", message);
+}
+`
+
+	SampleVulnerableHSC4 = `
+#include <stdio.h>
+#include <stdlib.h>
+
+void ping(const char *host) {
+    char command[256];
+    snprintf(command, sizeof(command), "ping -c 1 %s", host);
+    system(command);
+}
+`
+	SampleSafeHSC4 = `
+#include <unistd.h>
+
+void ping(const char *host) {
+    execlp("ping", "ping", "-c", "1", host, (char *) NULL);
+}
+`
+
+	SampleVulnerableHSC5 = `
+#include <stdlib.h>
+#include <time.h>
+
+void generate_key(unsigned char *key, size_t len) {
+    srand(time(NULL));
+    for (size_t i = 0; i < len; i++) {
+        key[i] = rand() % 256;
+    }
+}
+`
+	SampleSafeHSC5 = `
+#include <sys/random.h>
+
+void generate_key(unsigned char *key, size_t len) {
+    getrandom(key, len, 0);
+}
+`
+
+	SampleVulnerableHSC6 = `
+#include <stdlib.h>
+#include <string.h>
+
+char *duplicate(const char *input, size_t len) {
+    char *copy = malloc(len + 1);
+    memcpy(copy, input, len);
+    copy[len] = '\0';
+    return copy;
+}
+`
+	SampleSafeHSC6 = `
+#include <stdlib.h>
+#include <string.h>
+
+char *duplicate(const char *input, size_t len) {
+    char *copy = malloc(len + 1);
+    if (copy == NULL) {
+        return NULL;
+    }
+    memcpy(copy, input, len);
+    copy[len] = '\0';
+    return copy;
+}
+`
+)
@@ -0,0 +1,61 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cicd
+
+import (
+	"path/filepath"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/services/engines"
+)
+
+func NewRules() *engines.PathRuleManager {
+	return engines.NewPathRuleManager(Rules(), IsPipeline)
+}
+
+// IsPipeline return true if path is a CI pipeline definition, e.g:
+// .github/workflows/*.yml, action.yml of GitHub composite actions,
+// .gitlab-ci.yml and included pipelines like build.gitlab-ci.yml.
+func IsPipeline(path string) bool {
+	path = filepath.ToSlash(path)
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+
+	return strings.HasSuffix(filepath.Dir(path), ".github/workflows") ||
+		name == "action" ||
+		strings.HasSuffix(name, ".gitlab-ci")
+}
+
+// Rules return all rules registred to CI pipelines engine, which handle
+// GitHub Actions workflows and GitLab CI pipelines.
+func Rules() []engine.Rule {
+	return []engine.Rule{
+		// And rules
+		NewPullRequestTargetWithHeadCheckout(),
+		NewPlaintextSecretInPipeline(),
+
+		// Or rules
+		NewScriptInjectionFromEventContext(),
+
+		// Regular rules
+		NewUnpinnedThirdPartyAction(),
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package cicd
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+
+	"github.com/mosajjal/horusec/pkg/services/engines"
+)
+
+var (
+	actionReferenceRegex = regexp.MustCompile(`\buses:\s*["']?([\w.-]+)/[\w./-]+@([^\s"'#]+)`)
+	commitSHARegex       = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// trustedActionOwners are the owners of the actions maintained by GitHub, which are not reported
+// by HS-CICD-3 when referenced by a tag or branch.
+var trustedActionOwners = []string{"actions", "github"}
+
+func NewScriptInjectionFromEventContext() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-CICD-1",
+			Name:          "Script Injection From Event Context",
+			Description:   "The workflow interpolates a value controlled by whoever triggers the event, such as an issue title, a pull request body, a comment or a branch name, directly into a script with ${{ }}. The expression is replaced before the script runs, so a crafted value can execute arbitrary commands with the workflow token and secrets. Pass the value through an environment variable and reference it as \"$VAR\" instead. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSCICD1,
+			UnsafeExample: SampleVulnerableHSCICD1,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^[ \t]*(?:-[ \t]+)?(?:run|script):[^\n]*\$\{\{\s*github\.(?:head_ref|event\.(?:issue\.(?:title|body)|pull_request\.(?:title|body|head\.(?:ref|label|repo\.default_branch))|comment\.body|review\.body|review_comment\.body|discussion\.(?:title|body)|pages\.[^}]*\.page_name|commits\.[^}]*\.(?:message|author\.(?:email|name))|head_commit\.(?:message|author\.(?:email|name))|workflow_run\.(?:head_branch|head_commit\.message)))\b`),
+			regexp.MustCompile(`(?m)^[ \t]*(?:[^\s\w#-]|\w[\w-]*[^\w\s:#-]|\w[\w-]*[ \t])[^\n]*?\$\{\{\s*github\.(?:head_ref|event\.(?:issue\.(?:title|body)|pull_request\.(?:title|body|head\.(?:ref|label|repo\.default_branch))|comment\.body|review\.body|review_comment\.body|discussion\.(?:title|body)|pages\.[^}]*\.page_name|commits\.[^}]*\.(?:message|author\.(?:email|name))|head_commit\.(?:message|author\.(?:email|name))|workflow_run\.(?:head_branch|head_commit\.message)))\b`),
+		},
+	}
+}
+
+func NewPullRequestTargetWithHeadCheckout() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-CICD-2",
+			Name:          "Pull Request Target With Checkout Of Pull Request Head",
+			Description:   "Workflows triggered by pull_request_target run with a write token and access to the repository secrets, even for pull requests coming from forks. Checking out the pull request head and building or running it executes untrusted code in this privileged context. Use the pull_request trigger for building untrusted code, or never run code from the checked out head. For more information checkout the CWE-829 (https://cwe.mitre.org/data/definitions/829.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSCICD2,
+			UnsafeExample: SampleVulnerableHSCICD2,
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bref:\s*["']?\$\{\{\s*(?:github\.event\.pull_request\.head\.(?:sha|ref)|github\.head_ref)\s*\}\}`),
+			regexp.MustCompile(`\bpull_request_target\b`),
+			regexp.MustCompile(`\buses:\s*["']?actions/checkout@`),
+		},
+	}
+}
+
+func NewUnpinnedThirdPartyAction() *engines.CheckRule {
+	return &engines.CheckRule{
+		Rule: &text.Rule{
+			Metadata: engine.Metadata{
+				ID:            "HS-CICD-3",
+				Name:          "Unpinned Third Party Action",
+				Description:   "A third party action is referenced by a tag or branch instead of a full commit SHA. Tags and branches are mutable, so whoever controls the action repository can change the code that runs in the workflow with access to its token and secrets. Pin the action to the full length commit SHA of a reviewed release. For more information checkout the CWE-829 (https://cwe.mitre.org/data/definitions/829.html) advisory.",
+				Severity:      severities.Medium.ToString(),
+				Confidence:    confidence.High.ToString(),
+				SafeExample:   SampleSafeHSCICD3,
+				UnsafeExample: SampleVulnerableHSCICD3,
+			},
+			Type: text.Regular,
+			Expressions: []*regexp.Regexp{
+				actionReferenceRegex,
+			},
+		},
+		Check: isUnpinnedThirdPartyAction,
+	}
+}
+
+// isUnpinnedThirdPartyAction return true if the action referenced on the line of finding is not
+// owned by GitHub and its ref is a tag or branch instead of a full length commit SHA.
+func isUnpinnedThirdPartyAction(_ []byte, finding *engine.Finding) bool {
+	match := actionReferenceRegex.FindStringSubmatch(finding.CodeSample)
+	if match == nil {
+		return false
+	}
+
+	for _, owner := range trustedActionOwners {
+		if strings.EqualFold(match[1], owner) {
+			return false
+		}
+	}
+
+	return !commitSHARegex.MatchString(match[2])
+}
+
+func NewPlaintextSecretInPipeline() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-CICD-4",
+			Name:          "Plaintext Secret In Pipeline",
+			Description:   "A secret value is written in plaintext in the pipeline definition, so anyone with read access to the repository, its forks or its history can use it. Store the value in the CI secret store and reference it, e.g. ${{ secrets.NAME }} on GitHub Actions or a masked CI/CD variable on GitLab. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSCICD4,
+			UnsafeExample: SampleVulnerableHSCICD4,
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?im)^[ \t]*\w*(?:password|passwd|secret|token|api_?key|access_?key|private_?key)\w*[ \t]*:[ \t]*["']?[^\s"'$]{4,}`),
+			regexp.MustCompile(`(?m)^(?:jobs|stages):|^[ \t]+(?:runs-on|steps|script):`),
+		},
+	}
+}
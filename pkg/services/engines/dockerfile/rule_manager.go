@@ -0,0 +1,71 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerfile
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/services/engines"
+)
+
+// Language is the language reported by language detection to Dockerfiles and
+// Containerfiles to select the Dockerfile engine. It is not available on horusec-devkit
+// languages enum, so the vulnerabilities found are reported as languages.Generic, like
+// the Dockerfile misconfigurations found by Trivy.
+const Language languages.Language = "Dockerfile"
+
+func NewRules() *engines.PathRuleManager {
+	return engines.NewPathRuleManager(Rules(), IsDockerfile)
+}
+
+// IsDockerfile return true if the base name of path is a Dockerfile or a Containerfile, e.g:
+// Dockerfile, Dockerfile.prod, app.Dockerfile and Containerfile. The Dockerfile.<suffix> form
+// is case sensitive, so source files like dockerfile.go are not matched, and ignore files of
+// a Dockerfile, like Dockerfile.dockerignore, are not Dockerfiles.
+func IsDockerfile(path string) bool {
+	name := filepath.Base(path)
+	if strings.HasSuffix(name, ".dockerignore") {
+		return false
+	}
+
+	for _, base := range []string{"Dockerfile", "Containerfile"} {
+		if strings.EqualFold(name, base) || strings.HasPrefix(name, base+".") ||
+			strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(base)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Rules return all rules registred to Dockerfile engine.
+func Rules() []engine.Rule {
+	return []engine.Rule{
+		// Or rules
+		NewUnpinnedPackageVersions(),
+
+		// Regular rules
+		NewContainerRunningAsRoot(),
+		NewMissingUserInstruction(),
+		NewAddFromRemoteURL(),
+		NewImageWithLatestTag(),
+		NewHardcodedSecretInEnvOrArg(),
+		NewPipingDownloadToShell(),
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package dockerfile
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+
+	"github.com/mosajjal/horusec/pkg/services/engines"
+)
+
+var (
+	fromInstructionRegex = regexp.MustCompile(`(?i)^[ \t]*FROM[ \t]+\S+`)
+	userInstructionRegex = regexp.MustCompile(`(?i)^[ \t]*USER[ \t]+\S+`)
+)
+
+func NewContainerRunningAsRoot() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-DOCKERFILE-1",
+			Name:          "Container Running As Root",
+			Description:   "The USER instruction sets root as the user that runs the container. A process running as root that is compromised can modify the container and makes escaping to the host easier. Create an unprivileged user and switch to it with USER before the entrypoint. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSDOCKERFILE1,
+			UnsafeExample: SampleVulnerableHSDOCKERFILE1,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?im)^[ \t]*USER[ \t]+(?:root|0)(?::\S+)?[ \t]*$`),
+		},
+	}
+}
+
+func NewMissingUserInstruction() *engines.CheckRule {
+	return &engines.CheckRule{
+		Rule: &text.Rule{
+			Metadata: engine.Metadata{
+				ID:            "HS-DOCKERFILE-2",
+				Name:          "Missing USER Instruction",
+				Description:   "The last stage of the Dockerfile does not contain any USER instruction, so the container runs as root by default. A process running as root that is compromised can modify the container and makes escaping to the host easier. Create an unprivileged user and switch to it with USER before the entrypoint. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+				Severity:      severities.Low.ToString(),
+				Confidence:    confidence.Medium.ToString(),
+				SafeExample:   SampleSafeHSDOCKERFILE2,
+				UnsafeExample: SampleVulnerableHSDOCKERFILE2,
+			},
+			Type: text.Regular,
+			Expressions: []*regexp.Regexp{
+				regexp.MustCompile(`(?im)^[ \t]*FROM[ \t]+\S+`),
+			},
+		},
+		Check: isLastStageWithoutUser,
+	}
+}
+
+// isLastStageWithoutUser return true if the FROM instruction of finding starts the last stage
+// of the Dockerfile and there is no USER instruction after it, since the USER of previous stages
+// doesn't apply to the image built by the last stage.
+func isLastStageWithoutUser(content []byte, finding *engine.Finding) bool {
+	lines := strings.Split(string(content), "\n")
+	if finding.SourceLocation.Line <= 0 || finding.SourceLocation.Line > len(lines) {
+		return false
+	}
+
+	for _, line := range lines[finding.SourceLocation.Line:] {
+		if fromInstructionRegex.MatchString(line) || userInstructionRegex.MatchString(line) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func NewAddFromRemoteURL() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-DOCKERFILE-3",
+			Name:          "ADD From Remote URL",
+			Description:   "Using ADD with a remote URL downloads the file at build time without any integrity check, so a compromised server or connection can change the content of the image. Download the file with curl or wget, verify its checksum and then use it, or use ADD --checksum. For more information checkout the CWE-494 (https://cwe.mitre.org/data/definitions/494.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSDOCKERFILE3,
+			UnsafeExample: SampleVulnerableHSDOCKERFILE3,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?im)^[ \t]*ADD[ \t]+(?:--(?:chown|chmod|link)\S*[ \t]+)*https?://`),
+		},
+	}
+}
+
+func NewImageWithLatestTag() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-DOCKERFILE-4",
+			Name:          "Base Image With Latest Tag",
+			Description:   "The base image uses the latest tag, so every build can silently pull a different image with different packages and vulnerabilities, making builds neither reproducible nor auditable. Pin the base image to a specific version or, preferably, to a digest. For more information checkout the CWE-1357 (https://cwe.mitre.org/data/definitions/1357.html) advisory.",
+			Severity:      severities.Low.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSDOCKERFILE4,
+			UnsafeExample: SampleVulnerableHSDOCKERFILE4,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?im)^[ \t]*FROM[ \t]+(?:--platform=\S+[ \t]+)?\S+:latest\b`),
+		},
+	}
+}
+
+func NewHardcodedSecretInEnvOrArg() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-DOCKERFILE-5",
+			Name:          "Hardcoded Secret In ENV Or ARG",
+			Description:   "A secret value is hardcoded in an ENV or ARG instruction. These values are stored in the image layers and metadata, so anyone able to pull the image can read them with docker history or docker inspect. Use build secrets (RUN --mount=type=secret) and provide runtime secrets through the orchestrator. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSDOCKERFILE5,
+			UnsafeExample: SampleVulnerableHSDOCKERFILE5,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?im)^[ \t]*(?:ENV|ARG)[ \t]+\w*(?:password|passwd|pwd|secret|token|api_?key|access_?key|private_?key)\w*(?:[ \t]*=[ \t]*|[ \t]+)["']?[^\s"'$]{4,}`),
+		},
+	}
+}
+
+func NewUnpinnedPackageVersions() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-DOCKERFILE-6",
+			Name:          "Package Installed Without Version Pinning",
+			Description:   "Installing packages without pinning their versions makes every build install whatever version is available at that moment, which breaks reproducibility and can introduce vulnerable or malicious versions. Pin the versions, e.g. apt-get install curl=7.88.1-10. For more information checkout the CWE-1357 (https://cwe.mitre.org/data/definitions/1357.html) advisory.",
+			Severity:      severities.Low.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSDOCKERFILE6,
+			UnsafeExample: SampleVulnerableHSDOCKERFILE6,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)\bapt(?:-get)?[ \t]+(?:-\S+[ \t]+)*install(?:(?:[ \t]|\\\r?\n)+(?:-\S+|[a-z0-9][a-z0-9.+-]*=\S+))*(?:[ \t]|\\\r?\n)+[a-z0-9][a-z0-9.+-]*(?:[ \t;&|]|\\|\r?\n|$)`),
+			regexp.MustCompile(`(?m)\bapk[ \t]+(?:-\S+[ \t]+)*add(?:(?:[ \t]|\\\r?\n)+(?:-\S+|[a-z0-9][a-z0-9.+_-]*[=~<>]\S+))*(?:[ \t]|\\\r?\n)+[a-z0-9][a-z0-9.+_-]*(?:[ \t;&|]|\\|\r?\n|$)`),
+		},
+	}
+}
+
+func NewPipingDownloadToShell() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-DOCKERFILE-7",
+			Name:          "Download Piped To Shell",
+			Description:   "Piping the output of curl or wget straight into a shell executes whatever the remote server returns during the build, without any integrity check. A compromised server, DNS or proxy leads to arbitrary code in the image. Download the script to a file, verify its checksum or signature and only then execute it. For more information checkout the CWE-494 (https://cwe.mitre.org/data/definitions/494.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSDOCKERFILE7,
+			UnsafeExample: SampleVulnerableHSDOCKERFILE7,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:curl|wget)\b[^|\n]*\|[ \t]*(?:sudo[ \t]+)?(?:/usr)?(?:/bin/)?(?:sh|bash|zsh|ash|dash)\b`),
+		},
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package golang
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewSQLInjectionWithStringConcatenation() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-1",
+			Name:          "SQL Injection",
+			Description:   "SQL queries built by concatenating strings or formatting them with fmt.Sprintf allow user controlled values to change the meaning of the query. Use parameterized queries with placeholders (e.g. db.Query(\"SELECT * FROM users WHERE id = $1\", id)) instead. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSGO1,
+			UnsafeExample: SampleVulnerableHSGO1,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`"(?i:\s*(?:SELECT\s[^"]*FROM|INSERT\s+INTO|UPDATE\s[^"]*SET|DELETE\s+FROM))[^"]*"\s*\+\s*\w`),
+			regexp.MustCompile(`fmt\.Sprintf\(\s*"(?i:\s*(?:SELECT|INSERT|UPDATE|DELETE)\s)[^"]*%[sv]`),
+		},
+	}
+}
+
+func NewCommandInjectionWithTaintedInput() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-2",
+			Name:          "Command Injection",
+			Description:   "Executing a command whose name or shell script is not a constant allows an attacker that controls the input to run arbitrary commands on the host. Avoid passing user input to exec.Command, never run it through a shell with \"-c\" and validate arguments against an allow list. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSGO2,
+			UnsafeExample: SampleVulnerableHSGO2,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`exec\.Command\(\s*[a-zA-Z_][\w.]*(?:\[[^\]]*\])?\s*[,)]`),
+			regexp.MustCompile(`exec\.CommandContext\(\s*\w+,\s*[a-zA-Z_][\w.]*(?:\[[^\]]*\])?\s*[,)]`),
+			regexp.MustCompile(`exec\.Command(?:Context)?\((?:\s*\w+,)?\s*"(?:/bin/)?(?:sh|bash|zsh|cmd|cmd\.exe|powershell)"\s*,\s*"[-/][cC]"\s*,\s*(?:[^"\s]|"[^"]*"\s*\+)`),
+		},
+	}
+}
+
+func NewWeakHashingFunction() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-3",
+			Name:          "Weak Hashing Function",
+			Description:   "MD5 and SHA-1 are broken hash functions and must not be used to protect passwords, sign data or check integrity. Use SHA-256 or stronger for integrity and bcrypt, scrypt or argon2 for passwords. For more information checkout the CWE-328 (https://cwe.mitre.org/data/definitions/328.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSGO3,
+			UnsafeExample: SampleVulnerableHSGO3,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?:md5|sha1)\.(?:New|Sum)\(`),
+		},
+	}
+}
+
+func NewWeakCipherAlgorithm() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-4",
+			Name:          "Weak Cipher Algorithm",
+			Description:   "DES, Triple DES and RC4 are considered broken and do not provide confidentiality for sensitive data. Use AES with an authenticated mode such as GCM instead. For more information checkout the CWE-327 (https://cwe.mitre.org/data/definitions/327.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSGO4,
+			UnsafeExample: SampleVulnerableHSGO4,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?:des\.NewCipher|des\.NewTripleDESCipher|rc4\.NewCipher)\(`),
+		},
+	}
+}
+
+func NewTLSInsecureSkipVerify() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-5",
+			Name:          "TLS InsecureSkipVerify enabled",
+			Description:   "Setting InsecureSkipVerify to true disables the verification of the server certificate chain and host name, making the connection vulnerable to man-in-the-middle attacks. For more information checkout the CWE-295 (https://cwe.mitre.org/data/definitions/295.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSGO5,
+			UnsafeExample: SampleVulnerableHSGO5,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`InsecureSkipVerify\s*(?::|=)\s*true`),
+		},
+	}
+}
+
+func NewHardcodedCredentials() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-6",
+			Name:          "Hardcoded Credentials",
+			Description:   "Credentials such as passwords, tokens and API keys should not be hardcoded in source code, since anyone with access to the repository or the binary can read them. Load them from environment variables or a secret manager instead. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSGO6,
+			UnsafeExample: SampleVulnerableHSGO6,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)(?:password|passwd|pwd|secret|token|api_?key|access_?key)\w*\s*(?::=|=|:)\s*"[^"\s]{4,}"`),
+		},
+	}
+}
+
+func NewUnsafeFilePermissions() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-GO-7",
+			Name:          "Unsafe File Permissions",
+			Description:   "Files and directories created with world writable permissions (e.g. 0777, 0666 or os.ModePerm) can be modified by any user on the host. Grant only the permissions required, such as 0600 for files and 0750 for directories. For more information checkout the CWE-732 (https://cwe.mitre.org/data/definitions/732.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSGO7,
+			UnsafeExample: SampleVulnerableHSGO7,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?:os|ioutil)\.(?:WriteFile|OpenFile|Chmod|Mkdir|MkdirAll)\(.*,\s*(?:0o?)?[0-7]?[0-7][0-7][2367]\s*\)`),
+			regexp.MustCompile(`(?:os|ioutil)\.(?:WriteFile|OpenFile|Chmod|Mkdir|MkdirAll)\(.*,\s*os\.ModePerm\s*\)`),
+		},
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/utils/testutil"
+)
+
+func TestRulesVulnerableCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-GO-1",
+			Rule:     NewSQLInjectionWithStringConcatenation(),
+			Src:      SampleVulnerableHSGO1,
+			Filename: filepath.Join(tempDir, "HS-GO-1.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `return db.Query("SELECT * FROM users WHERE id = " + id)`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-1.test"),
+						Line:     5,
+						Column:   17,
+					},
+				},
+				{
+					CodeSample: `_, err := db.Exec(fmt.Sprintf("DELETE FROM users WHERE id = '%s'", id))`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-1.test"),
+						Line:     9,
+						Column:   19,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-GO-2",
+			Rule:     NewCommandInjectionWithTaintedInput(),
+			Src:      SampleVulnerableHSGO2,
+			Filename: filepath.Join(tempDir, "HS-GO-2.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "out, _ := exec.Command(name).Output()",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-2.test"),
+						Line:     6,
+						Column:   11,
+					},
+				},
+				{
+					CodeSample: `return exec.Command("sh", "-c", "ping -c 1 " + r.FormValue("host")).Run()`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-2.test"),
+						Line:     11,
+						Column:   8,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-GO-3",
+			Rule:     NewWeakHashingFunction(),
+			Src:      SampleVulnerableHSGO3,
+			Filename: filepath.Join(tempDir, "HS-GO-3.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "h := md5.New()",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-3.test"),
+						Line:     7,
+						Column:   6,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-GO-4",
+			Rule:     NewWeakCipherAlgorithm(),
+			Src:      SampleVulnerableHSGO4,
+			Filename: filepath.Join(tempDir, "HS-GO-4.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "block, err := des.NewCipher(key)",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-4.test"),
+						Line:     7,
+						Column:   15,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-GO-5",
+			Rule:     NewTLSInsecureSkipVerify(),
+			Src:      SampleVulnerableHSGO5,
+			Filename: filepath.Join(tempDir, "HS-GO-5.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "TLSClientConfig: &tls.Config{InsecureSkipVerify: true},",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-5.test"),
+						Line:     6,
+						Column:   31,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-GO-6",
+			Rule:     NewHardcodedCredentials(),
+			Src:      SampleVulnerableHSGO6,
+			Filename: filepath.Join(tempDir, "HS-GO-6.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `password := "Sup3rS3cret!"`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-6.test"),
+						Line:     5,
+						Column:   1,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-GO-7",
+			Rule:     NewUnsafeFilePermissions(),
+			Src:      SampleVulnerableHSGO7,
+			Filename: filepath.Join(tempDir, "HS-GO-7.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "return os.WriteFile(path, data, 0666)",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-7.test"),
+						Line:     8,
+						Column:   8,
+					},
+				},
+				{
+					CodeSample: "if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-GO-7.test"),
+						Line:     5,
+						Column:   11,
+					},
+				},
+			},
+		},
+	}
+
+	testutil.TestVulnerableCode(t, testcases)
+}
+
+func TestRulesSafeCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-GO-1",
+			Rule:     NewSQLInjectionWithStringConcatenation(),
+			Src:      SampleSafeHSGO1,
+			Filename: filepath.Join(tempDir, "HS-GO-1.test"),
+		},
+		{
+			Name:     "HS-GO-2",
+			Rule:     NewCommandInjectionWithTaintedInput(),
+			Src:      SampleSafeHSGO2,
+			Filename: filepath.Join(tempDir, "HS-GO-2.test"),
+		},
+		{
+			Name:     "HS-GO-3",
+			Rule:     NewWeakHashingFunction(),
+			Src:      SampleSafeHSGO3,
+			Filename: filepath.Join(tempDir, "HS-GO-3.test"),
+		},
+		{
+			Name:     "HS-GO-4",
+			Rule:     NewWeakCipherAlgorithm(),
+			Src:      SampleSafeHSGO4,
+			Filename: filepath.Join(tempDir, "HS-GO-4.test"),
+		},
+		{
+			Name:     "HS-GO-5",
+			Rule:     NewTLSInsecureSkipVerify(),
+			Src:      SampleSafeHSGO5,
+			Filename: filepath.Join(tempDir, "HS-GO-5.test"),
+		},
+		{
+			Name:     "HS-GO-6",
+			Rule:     NewHardcodedCredentials(),
+			Src:      SampleSafeHSGO6,
+			Filename: filepath.Join(tempDir, "HS-GO-6.test"),
+		},
+		{
+			Name:     "HS-GO-7",
+			Rule:     NewUnsafeFilePermissions(),
+			Src:      SampleSafeHSGO7,
+			Filename: filepath.Join(tempDir, "HS-GO-7.test"),
+		},
+	}
+
+	testutil.TestSafeCode(t, testcases)
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+const (
+	SampleVulnerableHSGO1 = `
+package main
+
+func GetUser(db *sql.DB, id string) (*sql.Rows, error) {
+	return db.Query("SELECT * FROM users WHERE id = " + id)
+}
+
+func DeleteUser(db *sql.DB, id string) error {
+	_, err := db.Exec(fmt.Sprintf("DELETE FROM users WHERE id = '%s'", id))
+	return err
+}
+`
+	SampleSafeHSGO1 = `
+package main
+
+func GetUser(db *sql.DB, id string) (*sql.Rows, error) {
+	return db.Query("SELECT * FROM users WHERE id = $1", id)
+}
+
+func DeleteUser(db *sql.DB, id string) error {
+	_, err := db.Exec("DELETE FROM users WHERE id = $1", id)
+	return err
+}
+`
+
+	SampleVulnerableHSGO2 = `
+package main
+
+func Run(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("cmd")
+	out, _ := exec.Command(name).Output()
+	w.Write(out)
+}
+
+func Ping(r *http.Request) error {
+	return exec.Command("sh", "-c", "ping -c 1 " + r.FormValue("host")).Run()
+}
+`
+	SampleSafeHSGO2 = `
+package main
+
+func Status() ([]byte, error) {
+	return exec.Command("git", "status").Output()
+}
+
+func List() ([]byte, error) {
+	return exec.Command("sh", "-c", "ls -la").Output()
+}
+`
+
+	SampleVulnerableHSGO3 = `
+package main
+
+import "crypto/md5"
+
+func Hash(password string) []byte {
+	h := md5.New()
+	h.Write([]byte(password))
+	return h.Sum(nil)
+}
+`
+	SampleSafeHSGO3 = `
+package main
+
+import "crypto/sha256"
+
+func Hash(data string) [32]byte {
+	return sha256.Sum256([]byte(data))
+}
+`
+
+	SampleVulnerableHSGO4 = `
+package main
+
+import "crypto/des"
+
+func Encrypt(key, data []byte) ([]byte, error) {
+	block, err := des.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(data))
+	block.Encrypt(out, data)
+	return out, nil
+}
+`
+	SampleSafeHSGO4 = `
+package main
+
+import "crypto/aes"
+
+func Encrypt(key, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+`
+
+	SampleVulnerableHSGO5 = `
+package main
+
+func NewClient() *http.Client {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	return &http.Client{Transport: tr}
+}
+`
+	SampleSafeHSGO5 = `
+package main
+
+func NewClient() *http.Client {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+	return &http.Client{Transport: tr}
+}
+`
+
+	SampleVulnerableHSGO6 = `
+package main
+
+func Connect() (*sql.DB, error) {
+	password := "Sup3rS3cret!"
+	return sql.Open("postgres", "user=admin password="+password)
+}
+`
+	SampleSafeHSGO6 = `
+package main
+
+func Connect() (*sql.DB, error) {
+	password := os.Getenv("DB_PASSWORD")
+	return sql.Open("postgres", "user=admin password="+password)
+}
+`
+
+	SampleVulnerableHSGO7 = `
+package main
+
+func Save(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0666)
+}
+`
+	SampleSafeHSGO7 = `
+package main
+
+func Save(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+`
+)
@@ -0,0 +1,138 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package hcl
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewPublicS3BucketACL() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-HCL-1",
+			Name:          "Public S3 Bucket ACL",
+			Description:   "The public-read, public-read-write and authenticated-read canned ACLs grant access to the bucket content to anyone on the internet or to any AWS account. Keep the bucket private and grant access through bucket policies scoped to the principals that need it. For more information checkout the CWE-732 (https://cwe.mitre.org/data/definitions/732.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSHCL1,
+			UnsafeExample: SampleVulnerableHSHCL1,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bacl\s*=\s*"(?:public-read|public-read-write|authenticated-read)"`),
+		},
+	}
+}
+
+func NewSecurityGroupOpenToTheWorld() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-HCL-2",
+			Name:          "Security Group Open To The World",
+			Description:   "An ingress rule allowing 0.0.0.0/0 or ::/0 exposes the port to every address on the internet. Restrict the source CIDR blocks to the networks that really need to reach the resource. For more information checkout the CWE-284 (https://cwe.mitre.org/data/definitions/284.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSHCL2,
+			UnsafeExample: SampleVulnerableHSHCL2,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bingress\s*\{[^}]*\b(?:cidr_blocks|ipv6_cidr_blocks)\s*=\s*\[[^\]]*"(?:0\.0\.0\.0/0|::/0)"`),
+			regexp.MustCompile(`\btype\s*=\s*"ingress"[^}]*\b(?:cidr_blocks|ipv6_cidr_blocks)\s*=\s*\[[^\]]*"(?:0\.0\.0\.0/0|::/0)"`),
+			regexp.MustCompile(`\bcidr_ipv[46]\s*=\s*"(?:0\.0\.0\.0/0|::/0)"`),
+		},
+	}
+}
+
+func NewUnencryptedRDSStorage() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-HCL-3",
+			Name:          "Unencrypted RDS Storage",
+			Description:   "The database storage, its automated backups, read replicas and snapshots are not encrypted at rest, so anyone with access to the underlying storage can read the data. Set storage_encrypted = true and optionally a kms_key_id. For more information checkout the CWE-311 (https://cwe.mitre.org/data/definitions/311.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSHCL3,
+			UnsafeExample: SampleVulnerableHSHCL3,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bstorage_encrypted\s*=\s*false`),
+		},
+	}
+}
+
+func NewUnencryptedEBSVolume() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-HCL-4",
+			Name:          "Unencrypted EBS Volume",
+			Description:   "The EBS volume and the snapshots created from it are not encrypted at rest. Set encrypted = true on aws_ebs_volume resources and on the root_block_device and ebs_block_device blocks of instances and launch templates. For more information checkout the CWE-311 (https://cwe.mitre.org/data/definitions/311.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSHCL4,
+			UnsafeExample: SampleVulnerableHSHCL4,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bencrypted\s*=\s*false`),
+		},
+	}
+}
+
+func NewIAMPolicyWithFullAdminPrivileges() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-HCL-5",
+			Name:          "IAM Policy With Full Administrative Privileges",
+			Description:   "An IAM policy that allows every action (\"*\" or \"*:*\") grants full administrative privileges to whoever it is attached to, breaking the principle of least privilege. Grant only the actions and resources that are really needed. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSHCL5,
+			UnsafeExample: SampleVulnerableHSHCL5,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`"Action"\s*:\s*\[?\s*"\*(?::\*)?"`),
+			regexp.MustCompile(`\bAction\s*=\s*\[?\s*"\*(?::\*)?"`),
+			regexp.MustCompile(`\bactions\s*=\s*\[\s*"\*(?::\*)?"\s*\]`),
+		},
+	}
+}
+
+func NewLoggingDisabled() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-HCL-6",
+			Name:          "Logging Disabled",
+			Description:   "Logging is explicitly disabled for the resource, so access and changes will not be recorded and incidents cannot be investigated. Keep CloudTrail, load balancer access logs and other audit logs enabled. For more information checkout the CWE-778 (https://cwe.mitre.org/data/definitions/778.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSHCL6,
+			UnsafeExample: SampleVulnerableHSHCL6,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:enable_logging|logging_enabled|enable_log_file_validation)\s*=\s*false`),
+			regexp.MustCompile(`\b(?:access_logs|logging|logging_config|log_config)\s*\{[^}]*\benabled\s*=\s*false`),
+		},
+	}
+}
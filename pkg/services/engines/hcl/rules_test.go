@@ -0,0 +1,172 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcl
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/utils/testutil"
+)
+
+func TestRulesVulnerableCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-HCL-2",
+			Rule:     NewSecurityGroupOpenToTheWorld(),
+			Src:      SampleVulnerableHSHCL2,
+			Filename: filepath.Join(tempDir, "HS-HCL-2.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "ingress {",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-HCL-2.test"),
+						Line:     5,
+						Column:   2,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-HCL-5",
+			Rule:     NewIAMPolicyWithFullAdminPrivileges(),
+			Src:      SampleVulnerableHSHCL5,
+			Filename: filepath.Join(tempDir, "HS-HCL-5.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `"Action": "*",`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-HCL-5.test"),
+						Line:     11,
+						Column:   6,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-HCL-6",
+			Rule:     NewLoggingDisabled(),
+			Src:      SampleVulnerableHSHCL6,
+			Filename: filepath.Join(tempDir, "HS-HCL-6.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "enable_logging = false",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-HCL-6.test"),
+						Line:     5,
+						Column:   2,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-HCL-1",
+			Rule:     NewPublicS3BucketACL(),
+			Src:      SampleVulnerableHSHCL1,
+			Filename: filepath.Join(tempDir, "HS-HCL-1.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `acl    = "public-read"`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-HCL-1.test"),
+						Line:     4,
+						Column:   2,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-HCL-3",
+			Rule:     NewUnencryptedRDSStorage(),
+			Src:      SampleVulnerableHSHCL3,
+			Filename: filepath.Join(tempDir, "HS-HCL-3.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "storage_encrypted = false",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-HCL-3.test"),
+						Line:     6,
+						Column:   2,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-HCL-4",
+			Rule:     NewUnencryptedEBSVolume(),
+			Src:      SampleVulnerableHSHCL4,
+			Filename: filepath.Join(tempDir, "HS-HCL-4.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "encrypted         = false",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-HCL-4.test"),
+						Line:     5,
+						Column:   2,
+					},
+				},
+			},
+		},
+	}
+
+	testutil.TestVulnerableCode(t, testcases)
+}
+
+func TestRulesSafeCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-HCL-2",
+			Rule:     NewSecurityGroupOpenToTheWorld(),
+			Src:      SampleSafeHSHCL2,
+			Filename: filepath.Join(tempDir, "HS-HCL-2.test"),
+		},
+		{
+			Name:     "HS-HCL-5",
+			Rule:     NewIAMPolicyWithFullAdminPrivileges(),
+			Src:      SampleSafeHSHCL5,
+			Filename: filepath.Join(tempDir, "HS-HCL-5.test"),
+		},
+		{
+			Name:     "HS-HCL-6",
+			Rule:     NewLoggingDisabled(),
+			Src:      SampleSafeHSHCL6,
+			Filename: filepath.Join(tempDir, "HS-HCL-6.test"),
+		},
+		{
+			Name:     "HS-HCL-1",
+			Rule:     NewPublicS3BucketACL(),
+			Src:      SampleSafeHSHCL1,
+			Filename: filepath.Join(tempDir, "HS-HCL-1.test"),
+		},
+		{
+			Name:     "HS-HCL-3",
+			Rule:     NewUnencryptedRDSStorage(),
+			Src:      SampleSafeHSHCL3,
+			Filename: filepath.Join(tempDir, "HS-HCL-3.test"),
+		},
+		{
+			Name:     "HS-HCL-4",
+			Rule:     NewUnencryptedEBSVolume(),
+			Src:      SampleSafeHSHCL4,
+			Filename: filepath.Join(tempDir, "HS-HCL-4.test"),
+		},
+	}
+
+	testutil.TestSafeCode(t, testcases)
+}
@@ -0,0 +1,147 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcl
+
+const (
+	SampleVulnerableHSHCL1 = `
+resource "aws_s3_bucket" "assets" {
+  bucket = "company-assets"
+  acl    = "public-read"
+}
+`
+	SampleSafeHSHCL1 = `
+resource "aws_s3_bucket" "assets" {
+  bucket = "company-assets"
+  acl    = "private"
+}
+`
+
+	SampleVulnerableHSHCL2 = `
+resource "aws_security_group" "ssh" {
+  name = "allow-ssh"
+
+  ingress {
+    from_port   = 22
+    to_port     = 22
+    protocol    = "tcp"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+`
+	SampleSafeHSHCL2 = `
+resource "aws_security_group" "ssh" {
+  name = "allow-ssh"
+
+  ingress {
+    from_port   = 22
+    to_port     = 22
+    protocol    = "tcp"
+    cidr_blocks = ["10.0.0.0/16"]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+`
+
+	SampleVulnerableHSHCL3 = `
+resource "aws_db_instance" "main" {
+  engine            = "postgres"
+  instance_class    = "db.t3.micro"
+  allocated_storage = 20
+  storage_encrypted = false
+}
+`
+	SampleSafeHSHCL3 = `
+resource "aws_db_instance" "main" {
+  engine            = "postgres"
+  instance_class    = "db.t3.micro"
+  allocated_storage = 20
+  storage_encrypted = true
+}
+`
+
+	SampleVulnerableHSHCL4 = `
+resource "aws_ebs_volume" "data" {
+  availability_zone = "us-east-1a"
+  size              = 40
+  encrypted         = false
+}
+`
+	SampleSafeHSHCL4 = `
+resource "aws_ebs_volume" "data" {
+  availability_zone = "us-east-1a"
+  size              = 40
+  encrypted         = true
+}
+`
+
+	SampleVulnerableHSHCL5 = `
+resource "aws_iam_policy" "admin" {
+  name = "admin"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`
+	SampleSafeHSHCL5 = `
+resource "aws_iam_policy" "read_assets" {
+  name = "read-assets"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject"],
+      "Resource": "arn:aws:s3:::company-assets/*"
+    }
+  ]
+}
+POLICY
+}
+`
+
+	SampleVulnerableHSHCL6 = `
+resource "aws_cloudtrail" "main" {
+  name           = "main"
+  s3_bucket_name = aws_s3_bucket.trail.id
+  enable_logging = false
+}
+`
+	SampleSafeHSHCL6 = `
+resource "aws_cloudtrail" "main" {
+  name                       = "main"
+  s3_bucket_name             = aws_s3_bucket.trail.id
+  enable_logging             = true
+  enable_log_file_validation = true
+}
+`
+)
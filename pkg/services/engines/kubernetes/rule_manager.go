@@ -15,17 +15,25 @@
 package kubernetes
 
 import (
+	"path/filepath"
+	"strings"
+
 	engine "github.com/ZupIT/horusec-engine"
 
 	"github.com/mosajjal/horusec/pkg/services/engines"
+	"github.com/mosajjal/horusec/pkg/services/engines/cicd"
 )
 
-func NewRules() *engines.RuleManager {
-	return engines.NewRuleManager(Rules(), extensions())
+func NewRules() *engines.PathRuleManager {
+	return engines.NewPathRuleManager(Rules(), IsManifest)
 }
 
-func extensions() []string {
-	return []string{".yaml", ".yml"}
+// IsManifest return true if path is a YAML file that is not a CI pipeline definition, since
+// pipelines are analyzed by the CICD engine and are not Kubernetes manifests.
+func IsManifest(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+
+	return (ext == ".yaml" || ext == ".yml") && !cicd.IsPipeline(path)
 }
 
 // Rules return all Rules registred to Kubernetes engine.
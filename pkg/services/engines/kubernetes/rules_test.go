@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	engine "github.com/ZupIT/horusec-engine"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/mosajjal/horusec/pkg/utils/testutil"
 )
@@ -236,3 +237,20 @@ func TestRulesSafeCode(t *testing.T) {
 
 	testutil.TestSafeCode(t, testcases)
 }
+
+func TestIsManifest(t *testing.T) {
+	testcases := map[string]bool{
+		filepath.Join("k8s", "deployment.yaml"):             true,
+		filepath.Join("chart", "templates", "service.yml"):  true,
+		"docker-compose.yml":                                true,
+		filepath.Join(".github", "workflows", "ci.yml"):     false,
+		filepath.Join("actions", "setup", "action.yaml"):    false,
+		".gitlab-ci.yml":                                    false,
+		filepath.Join("k8s", "deployment.json"):             false,
+		filepath.Join("chart", "templates", "_helpers.tpl"): false,
+	}
+
+	for path, expected := range testcases {
+		assert.Equal(t, expected, IsManifest(path), path)
+	}
+}
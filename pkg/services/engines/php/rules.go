@@ -0,0 +1,171 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package php
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewCodeInjectionWithEval() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-1",
+			Name:          "Code Injection",
+			Description:   "The eval and assert functions execute the PHP code they receive. Calling them with values that are not constant allows an attacker that controls the value to execute arbitrary code on the server. Avoid dynamic code evaluation entirely. For more information checkout the CWE-95 (https://cwe.mitre.org/data/definitions/95.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPHP1,
+			UnsafeExample: SampleVulnerableHSPHP1,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:eval|assert)\s*\(\s*[^"'\s)]`),
+		},
+	}
+}
+
+func NewInsecureDeserialization() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-2",
+			Name:          "Insecure Deserialization",
+			Description:   "Calling unserialize without the allowed_classes option lets serialized data instantiate any class, triggering magic methods such as __wakeup and __destruct that can lead to remote code execution. Use json_decode or pass ['allowed_classes' => false]. For more information checkout the CWE-502 (https://cwe.mitre.org/data/definitions/502.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPHP2,
+			UnsafeExample: SampleVulnerableHSPHP2,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bunserialize\s*\(\s*[^,()]*(?:\([^()]*\))?[^,()]*\)`),
+		},
+	}
+}
+
+func NewFileInclusionWithUserInput() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-3",
+			Name:          "File Inclusion",
+			Description:   "Using request data to build the path given to include or require allows an attacker to load arbitrary local or remote files and execute them as PHP code. Map the user choice to an allow list of files instead. For more information checkout the CWE-98 (https://cwe.mitre.org/data/definitions/98.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPHP3,
+			UnsafeExample: SampleVulnerableHSPHP3,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:include|include_once|require|require_once)\b[^;\n]*\$_(?:GET|POST|REQUEST|COOKIE)\b`),
+		},
+	}
+}
+
+func NewSQLInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-4",
+			Name:          "SQL Injection",
+			Description:   "Building a SQL query by concatenating or interpolating variables allows user controlled values to change the meaning of the query. Use prepared statements with bound parameters (mysqli::prepare or PDO::prepare) instead. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPHP4,
+			UnsafeExample: SampleVulnerableHSPHP4,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:mysqli_query|mysqli_multi_query|mysql_query|pg_query|sqlite_query)\s*\([^;\n]*(?:["']\s*\.\s*\$|"[^"]*\$\w)`),
+			regexp.MustCompile(`->(?:query|exec|multi_query)\s*\([^;\n]*(?:["']\s*\.\s*\$|"[^"]*\$\w)`),
+		},
+	}
+}
+
+func NewWeakPasswordHashing() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-5",
+			Name:          "Weak Password Hashing",
+			Description:   "MD5 and SHA-1 are fast, broken hash functions and must not be used to store passwords, since they can be brute forced quickly. Use password_hash and password_verify instead. For more information checkout the CWE-916 (https://cwe.mitre.org/data/definitions/916.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPHP5,
+			UnsafeExample: SampleVulnerableHSPHP5,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:md5|sha1)\s*\([^;\n]*\$\w*(?i:pass|pwd)`),
+			regexp.MustCompile(`\bhash\s*\(\s*["'](?i:md5|sha1)["']\s*,[^;\n]*\$\w*(?i:pass|pwd)`),
+		},
+	}
+}
+
+func NewExtractWithUserInput() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-6",
+			Name:          "Variable Overwrite With extract",
+			Description:   "Calling extract on request data creates a local variable for every parameter sent by the client, allowing an attacker to overwrite variables such as $isAdmin or $config. Read the expected keys explicitly instead. For more information checkout the CWE-621 (https://cwe.mitre.org/data/definitions/621.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPHP6,
+			UnsafeExample: SampleVulnerableHSPHP6,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bextract\s*\(\s*\$_(?:GET|POST|REQUEST|COOKIE|FILES|SERVER)\b`),
+		},
+	}
+}
+
+func NewCommandInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-7",
+			Name:          "Command Injection",
+			Description:   "Passing request data to system, exec, shell_exec, passthru, popen or proc_open allows an attacker to run arbitrary commands on the server. Avoid shell commands or escape every argument with escapeshellarg. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPHP7,
+			UnsafeExample: SampleVulnerableHSPHP7,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:system|exec|shell_exec|passthru|popen|proc_open|pcntl_exec)\s*\(\s*(?:["'][^"']*["']\s*\.\s*)?\$_(?:GET|POST|REQUEST|COOKIE)\b`),
+		},
+	}
+}
+
+func NewReflectedXSS() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PHP-8",
+			Name:          "Cross-Site Scripting (XSS)",
+			Description:   "Printing request data directly in the response allows an attacker to inject HTML and JavaScript that runs in the browser of other users. Encode the output with htmlspecialchars before printing it. For more information checkout the CWE-79 (https://cwe.mitre.org/data/definitions/79.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPHP8,
+			UnsafeExample: SampleVulnerableHSPHP8,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:echo|print)\s*\(?\s*(?:["'][^"']*["']\s*\.\s*)?\$_(?:GET|POST|REQUEST|COOKIE)\[`),
+		},
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package php
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/utils/testutil"
+)
+
+func TestRulesVulnerableCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-PHP-4",
+			Rule:     NewSQLInjection(),
+			Src:      SampleVulnerableHSPHP4,
+			Filename: filepath.Join(tempDir, "HS-PHP-4.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `$result = mysqli_query($conn, "SELECT * FROM users WHERE id = " . $id);`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-4.test"),
+						Line:     4,
+						Column:   10,
+					},
+				},
+				{
+					CodeSample: `$rows = $pdo->query("SELECT * FROM orders WHERE user_id = $id");`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-4.test"),
+						Line:     5,
+						Column:   12,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-5",
+			Rule:     NewWeakPasswordHashing(),
+			Src:      SampleVulnerableHSPHP5,
+			Filename: filepath.Join(tempDir, "HS-PHP-5.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "$hash = md5($password);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-5.test"),
+						Line:     4,
+						Column:   12,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-1",
+			Rule:     NewCodeInjectionWithEval(),
+			Src:      SampleVulnerableHSPHP1,
+			Filename: filepath.Join(tempDir, "HS-PHP-1.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "$result = eval($expression);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-1.test"),
+						Line:     4,
+						Column:   10,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-2",
+			Rule:     NewInsecureDeserialization(),
+			Src:      SampleVulnerableHSPHP2,
+			Filename: filepath.Join(tempDir, "HS-PHP-2.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "$cart = unserialize(base64_decode($_COOKIE['cart']));",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-2.test"),
+						Line:     3,
+						Column:   8,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-3",
+			Rule:     NewFileInclusionWithUserInput(),
+			Src:      SampleVulnerableHSPHP3,
+			Filename: filepath.Join(tempDir, "HS-PHP-3.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "include($_GET['page'] . '.php');",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-3.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-6",
+			Rule:     NewExtractWithUserInput(),
+			Src:      SampleVulnerableHSPHP6,
+			Filename: filepath.Join(tempDir, "HS-PHP-6.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "extract($_GET);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-6.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-7",
+			Rule:     NewCommandInjection(),
+			Src:      SampleVulnerableHSPHP7,
+			Filename: filepath.Join(tempDir, "HS-PHP-7.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "$output = shell_exec('ping -c 1 ' . $_GET['host']);",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-7.test"),
+						Line:     3,
+						Column:   10,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PHP-8",
+			Rule:     NewReflectedXSS(),
+			Src:      SampleVulnerableHSPHP8,
+			Filename: filepath.Join(tempDir, "HS-PHP-8.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `echo "Hello " . $_GET['name'];`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PHP-8.test"),
+						Line:     3,
+						Column:   0,
+					},
+				},
+			},
+		},
+	}
+
+	testutil.TestVulnerableCode(t, testcases)
+}
+
+func TestRulesSafeCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-PHP-4",
+			Rule:     NewSQLInjection(),
+			Src:      SampleSafeHSPHP4,
+			Filename: filepath.Join(tempDir, "HS-PHP-4.test"),
+		},
+		{
+			Name:     "HS-PHP-5",
+			Rule:     NewWeakPasswordHashing(),
+			Src:      SampleSafeHSPHP5,
+			Filename: filepath.Join(tempDir, "HS-PHP-5.test"),
+		},
+		{
+			Name:     "HS-PHP-1",
+			Rule:     NewCodeInjectionWithEval(),
+			Src:      SampleSafeHSPHP1,
+			Filename: filepath.Join(tempDir, "HS-PHP-1.test"),
+		},
+		{
+			Name:     "HS-PHP-2",
+			Rule:     NewInsecureDeserialization(),
+			Src:      SampleSafeHSPHP2,
+			Filename: filepath.Join(tempDir, "HS-PHP-2.test"),
+		},
+		{
+			Name:     "HS-PHP-3",
+			Rule:     NewFileInclusionWithUserInput(),
+			Src:      SampleSafeHSPHP3,
+			Filename: filepath.Join(tempDir, "HS-PHP-3.test"),
+		},
+		{
+			Name:     "HS-PHP-6",
+			Rule:     NewExtractWithUserInput(),
+			Src:      SampleSafeHSPHP6,
+			Filename: filepath.Join(tempDir, "HS-PHP-6.test"),
+		},
+		{
+			Name:     "HS-PHP-7",
+			Rule:     NewCommandInjection(),
+			Src:      SampleSafeHSPHP7,
+			Filename: filepath.Join(tempDir, "HS-PHP-7.test"),
+		},
+		{
+			Name:     "HS-PHP-8",
+			Rule:     NewReflectedXSS(),
+			Src:      SampleSafeHSPHP8,
+			Filename: filepath.Join(tempDir, "HS-PHP-8.test"),
+		},
+	}
+
+	testutil.TestSafeCode(t, testcases)
+}
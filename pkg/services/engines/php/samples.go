@@ -0,0 +1,120 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package php
+
+const (
+	SampleVulnerableHSPHP1 = `
+<?php
+$expression = $_GET['expression'];
+$result = eval($expression);
+echo htmlspecialchars($result);
+`
+	SampleSafeHSPHP1 = `
+<?php
+$value = (int) $_GET['value'];
+$result = $value * 2;
+echo htmlspecialchars($result);
+`
+
+	SampleVulnerableHSPHP2 = `
+<?php
+$cart = unserialize(base64_decode($_COOKIE['cart']));
+foreach ($cart as $item) {
+    echo htmlspecialchars($item->name);
+}
+`
+	SampleSafeHSPHP2 = `
+<?php
+$cart = unserialize(base64_decode($_COOKIE['cart']), ['allowed_classes' => false]);
+$preferences = json_decode($_COOKIE['preferences'], true);
+`
+
+	SampleVulnerableHSPHP3 = `
+<?php
+$page = $_GET['page'];
+include($_GET['page'] . '.php');
+`
+	SampleSafeHSPHP3 = `
+<?php
+$pages = ['home' => 'home.php', 'about' => 'about.php'];
+$page = $pages[$_GET['page'] ?? 'home'] ?? 'home.php';
+include(__DIR__ . '/pages/' . $page);
+`
+
+	SampleVulnerableHSPHP4 = `
+<?php
+$id = $_GET['id'];
+$result = mysqli_query($conn, "SELECT * FROM users WHERE id = " . $id);
+$rows = $pdo->query("SELECT * FROM orders WHERE user_id = $id");
+`
+	SampleSafeHSPHP4 = `
+<?php
+$stmt = $mysqli->prepare("SELECT * FROM users WHERE id = ?");
+$stmt->bind_param("i", $_GET['id']);
+$stmt->execute();
+`
+
+	SampleVulnerableHSPHP5 = `
+<?php
+function register($username, $password) {
+    $hash = md5($password);
+    save_user($username, $hash);
+}
+`
+	SampleSafeHSPHP5 = `
+<?php
+function register($username, $password) {
+    $hash = password_hash($password, PASSWORD_DEFAULT);
+    save_user($username, $hash);
+}
+`
+
+	SampleVulnerableHSPHP6 = `
+<?php
+$isAdmin = false;
+extract($_GET);
+if ($isAdmin) {
+    show_admin_panel();
+}
+`
+	SampleSafeHSPHP6 = `
+<?php
+$isAdmin = false;
+$name = $_GET['name'] ?? '';
+if ($isAdmin) {
+    show_admin_panel();
+}
+`
+
+	SampleVulnerableHSPHP7 = `
+<?php
+$output = shell_exec('ping -c 1 ' . $_GET['host']);
+echo "<pre>" . htmlspecialchars($output) . "</pre>";
+`
+	SampleSafeHSPHP7 = `
+<?php
+$output = shell_exec('ping -c 1 ' . escapeshellarg($host));
+echo "<pre>" . htmlspecialchars($output) . "</pre>";
+`
+
+	SampleVulnerableHSPHP8 = `
+<?php
+echo "Hello " . $_GET['name'];
+`
+	SampleSafeHSPHP8 = `
+<?php
+echo "Hello " . htmlspecialchars($_GET['name'], ENT_QUOTES, 'UTF-8');
+`
+)
@@ -0,0 +1,191 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package python
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewInsecureDeserializationWithPickle() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-1",
+			Name:          "Insecure Deserialization",
+			Description:   "The pickle module and its derivatives can execute arbitrary code while deserializing data, so they must never be used to load data from an untrusted source. Prefer a data only format such as JSON. For more information checkout the CWE-502 (https://cwe.mitre.org/data/definitions/502.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPYTHON1,
+			UnsafeExample: SampleVulnerableHSPYTHON1,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:pickle|cPickle|_pickle|dill|jsonpickle)\.(?:loads?|decode|Unpickler)\(`),
+		},
+	}
+}
+
+func NewUnsafeYAMLLoad() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-2",
+			Name:          "Unsafe YAML Load",
+			Description:   "Calling yaml.load without a safe loader allows a YAML document to instantiate arbitrary Python objects, which can lead to remote code execution. Use yaml.safe_load or pass Loader=yaml.SafeLoader. For more information checkout the CWE-502 (https://cwe.mitre.org/data/definitions/502.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPYTHON2,
+			UnsafeExample: SampleVulnerableHSPYTHON2,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`yaml\.(?:load|load_all)\((?:[^,)]*\)|[^)]*Loader\s*=\s*(?:yaml\.)?(?:Loader|UnsafeLoader|FullLoader)\b)`),
+			regexp.MustCompile(`yaml\.(?:unsafe_load|unsafe_load_all|full_load|full_load_all)\(`),
+		},
+	}
+}
+
+func NewOSCommandInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-3",
+			Name:          "OS Command Injection",
+			Description:   "Running commands through a shell (subprocess with shell=True, os.system or os.popen) with values that are not constant allows an attacker to inject arbitrary commands. Pass the arguments as a list to subprocess without shell=True. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPYTHON3,
+			UnsafeExample: SampleVulnerableHSPYTHON3,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`subprocess\.(?:call|run|Popen|check_call|check_output)\(.*shell\s*=\s*True`),
+			regexp.MustCompile(`os\.(?:system|popen)\(\s*(?:[^"'\s)]|[fF]["']|["'][^"']*["']\s*(?:\+|%|\.format))`),
+		},
+	}
+}
+
+func NewCodeInjectionWithEvalOrExec() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-4",
+			Name:          "Code Injection",
+			Description:   "The eval and exec functions run any Python code they receive. Calling them with values that are not constant allows an attacker to execute arbitrary code. Use ast.literal_eval to parse literals or avoid dynamic code execution entirely. For more information checkout the CWE-95 (https://cwe.mitre.org/data/definitions/95.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPYTHON4,
+			UnsafeExample: SampleVulnerableHSPYTHON4,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:eval|exec)\(\s*[^"'\s)]`),
+		},
+	}
+}
+
+func NewWeakHashingFunction() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-5",
+			Name:          "Weak Hashing Function",
+			Description:   "MD5 and SHA-1 are broken hash functions and must not be used to protect passwords, sign data or check integrity. Use hashlib.sha256 or stronger for integrity and bcrypt, scrypt or argon2 for passwords. For more information checkout the CWE-328 (https://cwe.mitre.org/data/definitions/328.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPYTHON5,
+			UnsafeExample: SampleVulnerableHSPYTHON5,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`hashlib\.(?:md5|sha1)\(`),
+			regexp.MustCompile(`hashlib\.new\(\s*["'](?i:md5|sha1)["']`),
+		},
+	}
+}
+
+func NewFlaskDebugModeEnabled() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-6",
+			Name:          "Flask Debug Mode Enabled",
+			Description:   "Running a Flask application with debug=True exposes the Werkzeug interactive debugger, which allows anyone that can trigger an error to execute arbitrary Python code on the server. Never enable debug mode in production. For more information checkout the CWE-489 (https://cwe.mitre.org/data/definitions/489.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPYTHON6,
+			UnsafeExample: SampleVulnerableHSPYTHON6,
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.run\([^)]*debug\s*=\s*True`),
+			regexp.MustCompile(`(?:from\s+flask\s+import|import\s+flask)`),
+		},
+	}
+}
+
+func NewDjangoDebugModeEnabled() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-7",
+			Name:          "Django Debug Mode Enabled",
+			Description:   "Setting DEBUG = True in Django settings displays detailed error pages with stack traces, settings and environment information to any visitor. Read the value from the environment and keep it disabled in production. For more information checkout the CWE-489 (https://cwe.mitre.org/data/definitions/489.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSPYTHON7,
+			UnsafeExample: SampleVulnerableHSPYTHON7,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^DEBUG\s*=\s*True\b`),
+		},
+	}
+}
+
+func NewDjangoHardcodedSecretKey() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-8",
+			Name:          "Django Hardcoded SECRET_KEY",
+			Description:   "The Django SECRET_KEY is used to sign sessions, password reset tokens and other cryptographic values. Committing it to source code allows anyone with access to the repository to forge them. Load it from the environment or a secret manager instead. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPYTHON8,
+			UnsafeExample: SampleVulnerableHSPYTHON8,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^SECRET_KEY\s*=\s*["'][^"']+["']`),
+		},
+	}
+}
+
+func NewRequestsWithoutCertificateValidation() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-PYTHON-9",
+			Name:          "Requests Without Certificate Validation",
+			Description:   "Passing verify=False to requests disables the verification of the server TLS certificate, making the connection vulnerable to man-in-the-middle attacks. For more information checkout the CWE-295 (https://cwe.mitre.org/data/definitions/295.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSPYTHON9,
+			UnsafeExample: SampleVulnerableHSPYTHON9,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.(?:get|post|put|patch|delete|head|options|request)\(.*verify\s*=\s*False`),
+		},
+	}
+}
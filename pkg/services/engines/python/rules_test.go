@@ -0,0 +1,254 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/utils/testutil"
+)
+
+func TestRulesVulnerableCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-PYTHON-6",
+			Rule:     NewFlaskDebugModeEnabled(),
+			Src:      SampleVulnerableHSPYTHON6,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-6.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `app.run(host="0.0.0.0", debug=True)`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-6.test"),
+						Line:     7,
+						Column:   7,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-1",
+			Rule:     NewInsecureDeserializationWithPickle(),
+			Src:      SampleVulnerableHSPYTHON1,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-1.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "return pickle.loads(base64.b64decode(data))",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-1.test"),
+						Line:     6,
+						Column:   11,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-2",
+			Rule:     NewUnsafeYAMLLoad(),
+			Src:      SampleVulnerableHSPYTHON2,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-2.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "return yaml.load(f)",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-2.test"),
+						Line:     6,
+						Column:   15,
+					},
+				},
+				{
+					CodeSample: "return list(yaml.load_all(stream, Loader=yaml.UnsafeLoader))",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-2.test"),
+						Line:     9,
+						Column:   16,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-3",
+			Rule:     NewOSCommandInjection(),
+			Src:      SampleVulnerableHSPYTHON3,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-3.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `subprocess.call("ping -c 1 " + host, shell=True)`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-3.test"),
+						Line:     6,
+						Column:   4,
+					},
+				},
+				{
+					CodeSample: `os.system("nslookup " + domain)`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-3.test"),
+						Line:     9,
+						Column:   4,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-4",
+			Rule:     NewCodeInjectionWithEvalOrExec(),
+			Src:      SampleVulnerableHSPYTHON4,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-4.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "return eval(expression)",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-4.test"),
+						Line:     4,
+						Column:   11,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-5",
+			Rule:     NewWeakHashingFunction(),
+			Src:      SampleVulnerableHSPYTHON5,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-5.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "return hashlib.md5(password.encode()).hexdigest()",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-5.test"),
+						Line:     5,
+						Column:   11,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-7",
+			Rule:     NewDjangoDebugModeEnabled(),
+			Src:      SampleVulnerableHSPYTHON7,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-7.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "DEBUG = True",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-7.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-8",
+			Rule:     NewDjangoHardcodedSecretKey(),
+			Src:      SampleVulnerableHSPYTHON8,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-8.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `SECRET_KEY = "django-insecure-9x!l2k#w7q@r4z&t1v^m8n$p0b"`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-8.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-PYTHON-9",
+			Rule:     NewRequestsWithoutCertificateValidation(),
+			Src:      SampleVulnerableHSPYTHON9,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-9.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "return requests.get(url, timeout=10, verify=False)",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-PYTHON-9.test"),
+						Line:     5,
+						Column:   19,
+					},
+				},
+			},
+		},
+	}
+
+	testutil.TestVulnerableCode(t, testcases)
+}
+
+func TestRulesSafeCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-PYTHON-6",
+			Rule:     NewFlaskDebugModeEnabled(),
+			Src:      SampleSafeHSPYTHON6,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-6.test"),
+		},
+		{
+			Name:     "HS-PYTHON-1",
+			Rule:     NewInsecureDeserializationWithPickle(),
+			Src:      SampleSafeHSPYTHON1,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-1.test"),
+		},
+		{
+			Name:     "HS-PYTHON-2",
+			Rule:     NewUnsafeYAMLLoad(),
+			Src:      SampleSafeHSPYTHON2,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-2.test"),
+		},
+		{
+			Name:     "HS-PYTHON-3",
+			Rule:     NewOSCommandInjection(),
+			Src:      SampleSafeHSPYTHON3,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-3.test"),
+		},
+		{
+			Name:     "HS-PYTHON-4",
+			Rule:     NewCodeInjectionWithEvalOrExec(),
+			Src:      SampleSafeHSPYTHON4,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-4.test"),
+		},
+		{
+			Name:     "HS-PYTHON-5",
+			Rule:     NewWeakHashingFunction(),
+			Src:      SampleSafeHSPYTHON5,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-5.test"),
+		},
+		{
+			Name:     "HS-PYTHON-7",
+			Rule:     NewDjangoDebugModeEnabled(),
+			Src:      SampleSafeHSPYTHON7,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-7.test"),
+		},
+		{
+			Name:     "HS-PYTHON-8",
+			Rule:     NewDjangoHardcodedSecretKey(),
+			Src:      SampleSafeHSPYTHON8,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-8.test"),
+		},
+		{
+			Name:     "HS-PYTHON-9",
+			Rule:     NewRequestsWithoutCertificateValidation(),
+			Src:      SampleSafeHSPYTHON9,
+			Filename: filepath.Join(tempDir, "HS-PYTHON-9.test"),
+		},
+	}
+
+	testutil.TestSafeCode(t, testcases)
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+const (
+	SampleVulnerableHSPYTHON1 = `
+import pickle
+
+def load_session(request):
+    data = request.cookies.get("session")
+    return pickle.loads(base64.b64decode(data))
+`
+	SampleSafeHSPYTHON1 = `
+import json
+
+def load_session(request):
+    data = request.cookies.get("session")
+    return json.loads(base64.b64decode(data))
+`
+
+	SampleVulnerableHSPYTHON2 = `
+import yaml
+
+def read_config(path):
+    with open(path) as f:
+        return yaml.load(f)
+
+def read_all(stream):
+    return list(yaml.load_all(stream, Loader=yaml.UnsafeLoader))
+`
+	SampleSafeHSPYTHON2 = `
+import yaml
+
+def read_config(path):
+    with open(path) as f:
+        return yaml.safe_load(f)
+
+def read_all(stream):
+    return list(yaml.load_all(stream, Loader=yaml.SafeLoader))
+`
+
+	SampleVulnerableHSPYTHON3 = `
+import os
+import subprocess
+
+def ping(host):
+    subprocess.call("ping -c 1 " + host, shell=True)
+
+def lookup(domain):
+    os.system("nslookup " + domain)
+`
+	SampleSafeHSPYTHON3 = `
+import os
+import subprocess
+
+def ping(host):
+    subprocess.call(["ping", "-c", "1", host])
+
+def clear():
+    os.system("clear")
+`
+
+	SampleVulnerableHSPYTHON4 = `
+def calculate(request):
+    expression = request.args.get("expression")
+    return eval(expression)
+`
+	SampleSafeHSPYTHON4 = `
+import ast
+
+def calculate(request):
+    value = request.args.get("value")
+    return ast.literal_eval(value)
+`
+
+	SampleVulnerableHSPYTHON5 = `
+import hashlib
+
+def hash_password(password):
+    return hashlib.md5(password.encode()).hexdigest()
+`
+	SampleSafeHSPYTHON5 = `
+import hashlib
+
+def checksum(data):
+    return hashlib.sha256(data).hexdigest()
+`
+
+	SampleVulnerableHSPYTHON6 = `
+from flask import Flask
+
+app = Flask(__name__)
+
+if __name__ == "__main__":
+    app.run(host="0.0.0.0", debug=True)
+`
+	SampleSafeHSPYTHON6 = `
+import os
+from flask import Flask
+
+app = Flask(__name__)
+
+if __name__ == "__main__":
+    app.run(host="127.0.0.1", debug=os.environ.get("FLASK_DEBUG") == "1")
+`
+
+	SampleVulnerableHSPYTHON7 = `
+import os
+
+DEBUG = True
+
+ALLOWED_HOSTS = ["*"]
+`
+	SampleSafeHSPYTHON7 = `
+import os
+
+DEBUG = os.environ.get("DJANGO_DEBUG") == "1"
+
+ALLOWED_HOSTS = ["example.com"]
+`
+
+	SampleVulnerableHSPYTHON8 = `
+import os
+
+SECRET_KEY = "django-insecure-9x!l2k#w7q@r4z&t1v^m8n$p0b"
+`
+	SampleSafeHSPYTHON8 = `
+import os
+
+SECRET_KEY = os.environ["DJANGO_SECRET_KEY"]
+`
+
+	SampleVulnerableHSPYTHON9 = `
+import requests
+
+def fetch(url):
+    return requests.get(url, timeout=10, verify=False)
+`
+	SampleSafeHSPYTHON9 = `
+import requests
+
+def fetch(url):
+    return requests.get(url, timeout=10)
+`
+)
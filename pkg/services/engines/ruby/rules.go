@@ -0,0 +1,209 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package ruby
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewCommandInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-1",
+			Name:          "Command Injection",
+			Description:   "Interpolating values into a shell command (backticks, %x, system, exec, spawn, IO.popen or Open3) allows an attacker that controls the value to run arbitrary commands. Pass the command and its arguments as separate parameters so no shell is involved. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSRUBY1,
+			UnsafeExample: SampleVulnerableHSRUBY1,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:system|exec|spawn|IO\.popen|Open3\.(?:popen[23e]?|capture[23]e?|pipeline\w*))[\s(]+"[^"]*#\{`),
+			regexp.MustCompile("`[^`\n]*#\\{[^`\n]*`"),
+			regexp.MustCompile(`%x[({\[][^)}\]\n]*#\{`),
+		},
+	}
+}
+
+func NewSQLInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-2",
+			Name:          "SQL Injection",
+			Description:   "Building SQL fragments with string interpolation or concatenation allows user controlled values to change the meaning of the query. Use placeholders (e.g. where(\"name = ?\", name)) or hash conditions instead. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSRUBY2,
+			UnsafeExample: SampleVulnerableHSRUBY2,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.(?:where|not|find_by_sql|select|order|reorder|group|having|joins|pluck|from|exists\?|execute|exec_query|select_all|select_value|count_by_sql)\(\s*"[^"]*#\{`),
+			regexp.MustCompile(`\.(?:where|not|find_by_sql|select|order|reorder|group|having|joins|pluck|from|exists\?|execute|exec_query|select_all|select_value|count_by_sql)\(\s*["'][^"']*["']\s*\+`),
+		},
+	}
+}
+
+func NewCodeInjection() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-3",
+			Name:          "Code Injection",
+			Description:   "Calling eval, instance_eval, class_eval or module_eval with request parameters or interpolated strings lets an attacker execute arbitrary Ruby code. Avoid dynamic evaluation of untrusted input. For more information checkout the CWE-95 (https://cwe.mitre.org/data/definitions/95.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSRUBY3,
+			UnsafeExample: SampleVulnerableHSRUBY3,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:eval|instance_eval|class_eval|module_eval)[\s(]+(?:params\b|request\.|cookies\b|"[^"]*#\{)`),
+		},
+	}
+}
+
+func NewInsecureDeserialization() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-4",
+			Name:          "Insecure Deserialization",
+			Description:   "Marshal.load, YAML.load, YAML.unsafe_load and Oj.load can instantiate arbitrary objects and lead to remote code execution when they receive untrusted data. Use JSON.parse or YAML.safe_load instead. For more information checkout the CWE-502 (https://cwe.mitre.org/data/definitions/502.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSRUBY4,
+			UnsafeExample: SampleVulnerableHSRUBY4,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:Marshal\.(?:load|restore)|YAML\.(?:load|unsafe_load|load_documents)|Psych\.(?:load|unsafe_load)|Oj\.load)\(`),
+		},
+	}
+}
+
+func NewOpenRedirect() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-5",
+			Name:          "Open Redirect",
+			Description:   "Redirecting to a location taken directly from request parameters allows an attacker to send users to a malicious site that looks like it belongs to the application. Redirect only to relative paths or to an allow list of hosts. For more information checkout the CWE-601 (https://cwe.mitre.org/data/definitions/601.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSRUBY5,
+			UnsafeExample: SampleVulnerableHSRUBY5,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bredirect(?:_to)?[\s(]+(?:params|request\.(?:params|referer|referrer))\b`),
+		},
+	}
+}
+
+func NewWeakHashingFunction() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-6",
+			Name:          "Weak Hashing Function",
+			Description:   "MD5 and SHA-1 are broken hash functions and must not be used to protect passwords, sign data or check integrity. Use SHA-256 or stronger for integrity and bcrypt, scrypt or argon2 for passwords. For more information checkout the CWE-328 (https://cwe.mitre.org/data/definitions/328.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSRUBY6,
+			UnsafeExample: SampleVulnerableHSRUBY6,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:OpenSSL::)?Digest::(?:MD5|SHA1)\b`),
+			regexp.MustCompile(`OpenSSL::Digest\.new\(\s*["'](?i:md5|sha1)["']`),
+		},
+	}
+}
+
+func NewSSLVerificationDisabled() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-7",
+			Name:          "SSL Certificate Verification Disabled",
+			Description:   "Using OpenSSL::SSL::VERIFY_NONE disables the verification of the server certificate, making the connection vulnerable to man-in-the-middle attacks. Use OpenSSL::SSL::VERIFY_PEER. For more information checkout the CWE-295 (https://cwe.mitre.org/data/definitions/295.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSRUBY7,
+			UnsafeExample: SampleVulnerableHSRUBY7,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`OpenSSL::SSL::VERIFY_NONE`),
+		},
+	}
+}
+
+func NewHardcodedCredentials() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-8",
+			Name:          "Hardcoded Credentials",
+			Description:   "Credentials such as passwords, tokens and API keys should not be hardcoded in source code, since anyone with access to the repository can read them. Load them from ENV, Rails credentials or a secret manager instead. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSRUBY8,
+			UnsafeExample: SampleVulnerableHSRUBY8,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)(?:password|passwd|secret|token|api_?key|access_?key)\w*\s*(?::|=>?)\s*["'][^"'\s#]{4,}["']`),
+		},
+	}
+}
+
+func NewUnsafeFilePermissions() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-9",
+			Name:          "Unsafe File Permissions",
+			Description:   "Files and directories with world writable permissions (e.g. 0777 or 0666) can be modified by any user on the host. Grant only the permissions required, such as 0600 for files and 0750 for directories. For more information checkout the CWE-732 (https://cwe.mitre.org/data/definitions/732.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSRUBY9,
+			UnsafeExample: SampleVulnerableHSRUBY9,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?:File|FileUtils)\.(?:chmod|chmod_R|mkdir_p|mkdir)\(?\s*(?:[^,\n]+,\s*)?(?:mode:\s*)?0o?[0-7]?[0-7][0-7][2367]\b`),
+		},
+	}
+}
+
+func NewMassAssignmentPermitAll() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-RUBY-10",
+			Name:          "Mass Assignment",
+			Description:   "Calling permit! on request parameters allows every attribute to be mass assigned, including fields such as admin or role that users should not control. List the allowed attributes explicitly with permit. For more information checkout the CWE-915 (https://cwe.mitre.org/data/definitions/915.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSRUBY10,
+			UnsafeExample: SampleVulnerableHSRUBY10,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.permit!`),
+		},
+	}
+}
@@ -15,7 +15,10 @@
 package engines
 
 import (
+	"os"
+
 	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
 )
 
 // RuleManager is a generic implementation of formatters.RuleManager
@@ -39,3 +42,64 @@ func (r *RuleManager) GetAllRules() []engine.Rule {
 func (r *RuleManager) GetAllExtensions() []string {
 	return r.extensions
 }
+
+// PathRuleManager is a RuleManager to files that are identified by their names instead
+// of their extensions, like Dockerfiles. All extensions are accepted by the engine and
+// the files analyzed are selected by MatchPath.
+type PathRuleManager struct {
+	*RuleManager
+	match func(path string) bool
+}
+
+func NewPathRuleManager(rules []engine.Rule, match func(path string) bool) *PathRuleManager {
+	return &PathRuleManager{
+		RuleManager: NewRuleManager(rules, []string{engine.AcceptAnyExtension}),
+		match:       match,
+	}
+}
+
+// MatchPath return true if the rules should be executed on the file of path.
+func (r *PathRuleManager) MatchPath(path string) bool {
+	return r.match(path)
+}
+
+// CheckRule is a text.Rule whose findings are checked by Go code before being reported, to
+// the rules that can't be expressed only with regular expressions. Only the findings that
+// Check return true to are reported, Check receives the content of the analyzed file.
+type CheckRule struct {
+	*text.Rule
+	Check func(content []byte, finding *engine.Finding) bool `json:"-"`
+}
+
+func (r *CheckRule) Run(path string) ([]engine.Finding, error) {
+	findings, err := r.Rule.Run(path)
+	if err != nil || len(findings) == 0 {
+		return findings, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	checked := make([]engine.Finding, 0, len(findings))
+	for index := range findings {
+		if r.Check(content, &findings[index]) {
+			checked = append(checked, findings[index])
+		}
+	}
+
+	return checked, nil
+}
+
+// TextRule return the text.Rule of a text.Rule or a CheckRule and false to other rule types.
+func TextRule(rule engine.Rule) (*text.Rule, bool) {
+	switch r := rule.(type) {
+	case *text.Rule:
+		return r, true
+	case *CheckRule:
+		return r.Rule, true
+	}
+
+	return nil, false
+}
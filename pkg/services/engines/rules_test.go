@@ -17,26 +17,35 @@ package engines_test
 import (
 	"testing"
 
-	"github.com/ZupIT/horusec-engine/text"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mosajjal/horusec/pkg/services/engines"
+	"github.com/mosajjal/horusec/pkg/services/engines/c"
+	"github.com/mosajjal/horusec/pkg/services/engines/cicd"
 	"github.com/mosajjal/horusec/pkg/services/engines/csharp"
 	"github.com/mosajjal/horusec/pkg/services/engines/dart"
+	"github.com/mosajjal/horusec/pkg/services/engines/dockerfile"
+	"github.com/mosajjal/horusec/pkg/services/engines/golang"
+	"github.com/mosajjal/horusec/pkg/services/engines/hcl"
 	"github.com/mosajjal/horusec/pkg/services/engines/java"
 	"github.com/mosajjal/horusec/pkg/services/engines/javascript"
 	"github.com/mosajjal/horusec/pkg/services/engines/kotlin"
 	"github.com/mosajjal/horusec/pkg/services/engines/kubernetes"
 	"github.com/mosajjal/horusec/pkg/services/engines/leaks"
 	"github.com/mosajjal/horusec/pkg/services/engines/nginx"
+	"github.com/mosajjal/horusec/pkg/services/engines/php"
+	"github.com/mosajjal/horusec/pkg/services/engines/python"
+	"github.com/mosajjal/horusec/pkg/services/engines/ruby"
+	"github.com/mosajjal/horusec/pkg/services/engines/shell"
 	"github.com/mosajjal/horusec/pkg/services/engines/swift"
+	"github.com/mosajjal/horusec/pkg/services/formatters"
 )
 
 func TestGetRules(t *testing.T) {
 	testcases := []struct {
 		engine             string
-		manager            *engines.RuleManager
+		manager            formatters.RuleManager
 		expectedTotalRules int
 	}{
 		{
@@ -84,6 +93,51 @@ func TestGetRules(t *testing.T) {
 			manager:            swift.NewRules(),
 			expectedTotalRules: 23,
 		},
+		{
+			engine:             "Go",
+			manager:            golang.NewRules(),
+			expectedTotalRules: 7,
+		},
+		{
+			engine:             "Python",
+			manager:            python.NewRules(),
+			expectedTotalRules: 9,
+		},
+		{
+			engine:             "Ruby",
+			manager:            ruby.NewRules(),
+			expectedTotalRules: 10,
+		},
+		{
+			engine:             "PHP",
+			manager:            php.NewRules(),
+			expectedTotalRules: 8,
+		},
+		{
+			engine:             "C",
+			manager:            c.NewRules(),
+			expectedTotalRules: 6,
+		},
+		{
+			engine:             "HCL",
+			manager:            hcl.NewRules(),
+			expectedTotalRules: 6,
+		},
+		{
+			engine:             "Shell",
+			manager:            shell.NewRules(),
+			expectedTotalRules: 6,
+		},
+		{
+			engine:             "Dockerfile",
+			manager:            dockerfile.NewRules(),
+			expectedTotalRules: 7,
+		},
+		{
+			engine:             "CICD",
+			manager:            cicd.NewRules(),
+			expectedTotalRules: 4,
+		},
 	}
 
 	for _, tt := range testcases {
@@ -93,8 +147,8 @@ func TestGetRules(t *testing.T) {
 			rulesID := map[string]bool{}
 
 			for _, rule := range rules {
-				r, ok := rule.(*text.Rule)
-				require.True(t, ok, "Expected rule type of text.Rule, got %T", rule)
+				r, ok := engines.TextRule(rule)
+				require.True(t, ok, "Expected rule type of text.Rule or engines.CheckRule, got %T", rule)
 				expressions += len(r.Expressions)
 
 				if rulesID[r.ID] == true {
@@ -0,0 +1,134 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll // multiple regex is not possible broken lines
+package shell
+
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+func NewPipingDownloadToShell() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-SHELL-1",
+			Name:          "Download Piped To Shell",
+			Description:   "Piping the output of curl or wget straight into a shell executes whatever the remote server returns, without any integrity check. A compromised server, DNS or proxy leads to arbitrary code execution. Download the script to a file, verify its checksum or signature and only then execute it. For more information checkout the CWE-494 (https://cwe.mitre.org/data/definitions/494.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSSHELL1,
+			UnsafeExample: SampleVulnerableHSSHELL1,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(?:curl|wget)\b[^|\n]*\|\s*(?:sudo\s+(?:-\w+\s+)*)?(?:/usr)?(?:/bin/)?(?:sh|bash|zsh|ksh|dash)\b`),
+		},
+	}
+}
+
+func NewEvalWithVariable() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-SHELL-2",
+			Name:          "Eval With Variable",
+			Description:   "Calling eval with the content of a variable executes it as shell code. When the variable contains data that comes from arguments, the environment or files, an attacker can inject arbitrary commands. Use arrays or case statements instead of building commands dynamically. For more information checkout the CWE-95 (https://cwe.mitre.org/data/definitions/95.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSSHELL2,
+			UnsafeExample: SampleVulnerableHSSHELL2,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\beval\s+["']?\$`),
+		},
+	}
+}
+
+func NewUnquotedVariableInRecursiveRemove() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-SHELL-3",
+			Name:          "Unquoted Variable In Recursive Remove",
+			Description:   "Using an unquoted variable as a path prefix in rm -rf removes the root directory when the variable is empty or unset, and splits the path when it contains spaces. Quote the variable and fail when it is empty, e.g. rm -rf \"${DIR:?}/\". For more information checkout the CWE-73 (https://cwe.mitre.org/data/definitions/73.html) advisory.",
+			Severity:      severities.High.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSSHELL3,
+			UnsafeExample: SampleVulnerableHSSHELL3,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\brm\s+(?:-\w+\s+)*-\w*[rR]\w*\s+(?:-\w+\s+)*\$\{?\w+\}?/`),
+		},
+	}
+}
+
+func NewInsecureFilePermissions() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-SHELL-4",
+			Name:          "Insecure File Permissions",
+			Description:   "Setting the 777 mode makes the file or directory readable, writable and executable by every user of the system, allowing them to tamper with its content. Grant only the permissions that the owner and group really need, such as 755 or 640. For more information checkout the CWE-732 (https://cwe.mitre.org/data/definitions/732.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.High.ToString(),
+			SafeExample:   SampleSafeHSSHELL4,
+			UnsafeExample: SampleVulnerableHSSHELL4,
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bchmod\s+(?:-\w+\s+)*0?777\b`),
+			regexp.MustCompile(`\bchmod\s+(?:-\w+\s+)*(?:a|ugo)\+rwx\b`),
+		},
+	}
+}
+
+func NewHardcodedSecretInExport() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-SHELL-5",
+			Name:          "Hardcoded Secret In Export",
+			Description:   "A secret value is hardcoded in an export statement, so anyone with access to the script or its history can read it, and it is passed to every child process. Load secrets at runtime from a secret manager or from environment variables provided by the caller. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:      severities.Critical.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSSHELL5,
+			UnsafeExample: SampleVulnerableHSSHELL5,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bexport\s+\w*(?i:password|passwd|pwd|secret|token|api_?key|access_?key|private_?key)\w*=["']?[^\s"'$]{4,}`),
+		},
+	}
+}
+
+func NewCredentialsExposedByXtrace() *text.Rule {
+	return &text.Rule{
+		Metadata: engine.Metadata{
+			ID:            "HS-SHELL-6",
+			Name:          "Credentials Exposed By Xtrace",
+			Description:   "The script enables command tracing with set -x and handles credentials afterwards without disabling it with set +x first. Every traced command is printed with its variables expanded, leaking the credentials to the terminal and to CI logs. Call set +x before handling secrets. For more information checkout the CWE-532 (https://cwe.mitre.org/data/definitions/532.html) advisory.",
+			Severity:      severities.Medium.ToString(),
+			Confidence:    confidence.Medium.ToString(),
+			SafeExample:   SampleSafeHSSHELL6,
+			UnsafeExample: SampleVulnerableHSSHELL6,
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bset\s+(?:-[a-wyz]*x[a-z]*|-o\s+xtrace)\b(?:[^+]|\+[^x])*?(?:\b\w*(?i:password|passwd|secret|token|api_?key)\w*=|--password\b|\blogin\b[^\n]*\s-p\s)`),
+		},
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+
+	"github.com/mosajjal/horusec/pkg/utils/testutil"
+)
+
+func TestRulesVulnerableCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-SHELL-4",
+			Rule:     NewInsecureFilePermissions(),
+			Src:      SampleVulnerableHSSHELL4,
+			Filename: filepath.Join(tempDir, "HS-SHELL-4.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "chmod -R 777 /var/www/uploads",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-SHELL-4.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-SHELL-1",
+			Rule:     NewPipingDownloadToShell(),
+			Src:      SampleVulnerableHSSHELL1,
+			Filename: filepath.Join(tempDir, "HS-SHELL-1.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "curl -fsSL https://get.example.com/install.sh | sudo bash",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-SHELL-1.test"),
+						Line:     3,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-SHELL-2",
+			Rule:     NewEvalWithVariable(),
+			Src:      SampleVulnerableHSSHELL2,
+			Filename: filepath.Join(tempDir, "HS-SHELL-2.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `eval "$action"`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-SHELL-2.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-SHELL-3",
+			Rule:     NewUnquotedVariableInRecursiveRemove(),
+			Src:      SampleVulnerableHSSHELL3,
+			Filename: filepath.Join(tempDir, "HS-SHELL-3.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "rm -rf $BUILD_DIR/",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-SHELL-3.test"),
+						Line:     4,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-SHELL-5",
+			Rule:     NewHardcodedSecretInExport(),
+			Src:      SampleVulnerableHSSHELL5,
+			Filename: filepath.Join(tempDir, "HS-SHELL-5.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: `export AWS_SECRET_ACCESS_KEY="wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"`,
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-SHELL-5.test"),
+						Line:     3,
+						Column:   0,
+					},
+				},
+			},
+		},
+		{
+			Name:     "HS-SHELL-6",
+			Rule:     NewCredentialsExposedByXtrace(),
+			Src:      SampleVulnerableHSSHELL6,
+			Filename: filepath.Join(tempDir, "HS-SHELL-6.test"),
+			Findings: []engine.Finding{
+				{
+					CodeSample: "set -ex",
+					SourceLocation: engine.Location{
+						Filename: filepath.Join(tempDir, "HS-SHELL-6.test"),
+						Line:     3,
+						Column:   0,
+					},
+				},
+			},
+		},
+	}
+
+	testutil.TestVulnerableCode(t, testcases)
+}
+
+func TestRulesSafeCode(t *testing.T) {
+	tempDir := t.TempDir()
+	testcases := []*testutil.RuleTestCase{
+		{
+			Name:     "HS-SHELL-4",
+			Rule:     NewInsecureFilePermissions(),
+			Src:      SampleSafeHSSHELL4,
+			Filename: filepath.Join(tempDir, "HS-SHELL-4.test"),
+		},
+		{
+			Name:     "HS-SHELL-1",
+			Rule:     NewPipingDownloadToShell(),
+			Src:      SampleSafeHSSHELL1,
+			Filename: filepath.Join(tempDir, "HS-SHELL-1.test"),
+		},
+		{
+			Name:     "HS-SHELL-2",
+			Rule:     NewEvalWithVariable(),
+			Src:      SampleSafeHSSHELL2,
+			Filename: filepath.Join(tempDir, "HS-SHELL-2.test"),
+		},
+		{
+			Name:     "HS-SHELL-3",
+			Rule:     NewUnquotedVariableInRecursiveRemove(),
+			Src:      SampleSafeHSSHELL3,
+			Filename: filepath.Join(tempDir, "HS-SHELL-3.test"),
+		},
+		{
+			Name:     "HS-SHELL-5",
+			Rule:     NewHardcodedSecretInExport(),
+			Src:      SampleSafeHSSHELL5,
+			Filename: filepath.Join(tempDir, "HS-SHELL-5.test"),
+		},
+		{
+			Name:     "HS-SHELL-6",
+			Rule:     NewCredentialsExposedByXtrace(),
+			Src:      SampleSafeHSSHELL6,
+			Filename: filepath.Join(tempDir, "HS-SHELL-6.test"),
+		},
+	}
+
+	testutil.TestSafeCode(t, testcases)
+}
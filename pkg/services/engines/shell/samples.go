@@ -0,0 +1,89 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+const (
+	SampleVulnerableHSSHELL1 = `
+#!/bin/bash
+curl -fsSL https://get.example.com/install.sh | sudo bash
+`
+	SampleSafeHSSHELL1 = `
+#!/bin/bash
+curl -fsSL -o install.sh https://get.example.com/install.sh
+echo "${INSTALLER_SHA256}  install.sh" | sha256sum -c -
+bash install.sh
+`
+
+	SampleVulnerableHSSHELL2 = `
+#!/bin/bash
+action="$1"
+eval "$action"
+`
+	SampleSafeHSSHELL2 = `
+#!/bin/bash
+case "$1" in
+  start) start_service ;;
+  stop) stop_service ;;
+esac
+`
+
+	SampleVulnerableHSSHELL3 = `
+#!/bin/bash
+BUILD_DIR=$(get_build_dir)
+rm -rf $BUILD_DIR/
+`
+	SampleSafeHSSHELL3 = `
+#!/bin/bash
+BUILD_DIR=$(get_build_dir)
+rm -rf "${BUILD_DIR:?}/"
+`
+
+	SampleVulnerableHSSHELL4 = `
+#!/bin/bash
+mkdir -p /var/www/uploads
+chmod -R 777 /var/www/uploads
+`
+	SampleSafeHSSHELL4 = `
+#!/bin/bash
+mkdir -p /var/www/uploads
+chmod -R 750 /var/www/uploads
+`
+
+	SampleVulnerableHSSHELL5 = `
+#!/bin/bash
+export AWS_SECRET_ACCESS_KEY="wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+aws s3 sync ./dist s3://company-assets
+`
+	SampleSafeHSSHELL5 = `
+#!/bin/bash
+export AWS_SECRET_ACCESS_KEY="$(vault kv get -field=secret aws/deploy)"
+aws s3 sync ./dist s3://company-assets
+`
+
+	SampleVulnerableHSSHELL6 = `
+#!/bin/bash
+set -ex
+DB_PASSWORD=$(vault kv get -field=password database/prod)
+psql "postgres://app:${DB_PASSWORD}@db/app" -f migrate.sql
+`
+	SampleSafeHSSHELL6 = `
+#!/bin/bash
+set -ex
+build_project
+set +x
+DB_PASSWORD=$(vault kv get -field=password database/prod)
+psql "postgres://app:${DB_PASSWORD}@db/app" -f migrate.sql
+`
+)
@@ -0,0 +1,26 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecc
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+
+	"github.com/mosajjal/horusec/pkg/services/engines/c"
+	"github.com/mosajjal/horusec/pkg/services/formatters"
+)
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return formatters.NewDefaultFormatter(service, c.NewRules(), languages.C)
+}
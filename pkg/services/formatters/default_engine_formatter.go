@@ -24,6 +24,8 @@ import (
 	engine "github.com/ZupIT/horusec-engine"
 
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/cache"
+	"github.com/mosajjal/horusec/pkg/services/engines"
 )
 
 type RuleManager interface {
@@ -31,12 +33,34 @@ type RuleManager interface {
 	GetAllExtensions() []string
 }
 
+// PathMatcher is implemented by the rule managers that select the files to analyze by
+// their paths, the rules are only executed on the files that MatchPath return true.
+type PathMatcher interface {
+	MatchPath(path string) bool
+}
+
+// Rendered contains the files rendered from the project files, like the Kubernetes manifests
+// rendered from Helm charts, that should be analyzed as they are deployed.
+//
+// MergeFindings merge the findings of project files with the findings of rendered files,
+// mapping the latter back to the project files that produced them.
+type Rendered interface {
+	Path() string
+	IsEmpty() bool
+	Remove()
+	MergeFindings(projectFindings, renderedFindings []engine.Finding) []engine.Finding
+}
+
+// RenderFunc render the files of a project path that should be analyzed as they are deployed.
+type RenderFunc func(path string) (Rendered, error)
+
 // DefaultFormatter is a formatter that can be used with horusec engines implementation
 type DefaultFormatter struct {
 	svc      IService
 	manager  RuleManager
 	language languages.Language
 	engine   *engine.Engine
+	render   RenderFunc
 }
 
 func NewDefaultFormatter(svc IService, manager RuleManager, language languages.Language) IFormatter {
@@ -48,6 +72,20 @@ func NewDefaultFormatter(svc IService, manager RuleManager, language languages.L
 	}
 }
 
+// NewDefaultFormatterWithRender create a new DefaultFormatter that also run the rules on the
+// files rendered by render, e.g. the Kubernetes manifests rendered from Helm charts.
+func NewDefaultFormatterWithRender(
+	svc IService, manager RuleManager, language languages.Language, render RenderFunc,
+) IFormatter {
+	return &DefaultFormatter{
+		svc:      svc,
+		manager:  manager,
+		language: language,
+		engine:   engine.NewEngine(0, manager.GetAllExtensions()...),
+		render:   render,
+	}
+}
+
 func (f *DefaultFormatter) StartAnalysis(src string) {
 	if f.svc.ToolIsToIgnore(tools.HorusecEngine) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored + tools.HorusecEngine.ToString())
@@ -60,7 +98,7 @@ func (f *DefaultFormatter) StartAnalysis(src string) {
 func (f *DefaultFormatter) execEngineAndParseResults(src string) error {
 	f.svc.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecEngine, f.language)
 
-	rules := append(f.manager.GetAllRules(), f.svc.GetCustomRulesByLanguage(f.language)...)
+	rules := f.getRules()
 	path := f.svc.GetConfigProjectPath()
 	if src != "" {
 		path = filepath.Join(path, src)
@@ -70,6 +108,112 @@ func (f *DefaultFormatter) execEngineAndParseResults(src string) error {
 	if err != nil {
 		return err
 	}
+
+	if f.render != nil {
+		if findings, err = f.execEngineOnRenderedFiles(path, rules, findings); err != nil {
+			return err
+		}
+	}
+
 	f.svc.ParseFindingsToVulnerabilities(findings, tools.HorusecEngine, f.language)
 	return nil
 }
+
+// execEngineOnRenderedFiles run the rules on the files rendered from path and merge their
+// findings with the findings of the project files.
+func (f *DefaultFormatter) execEngineOnRenderedFiles(
+	path string, rules []engine.Rule, findings []engine.Finding,
+) ([]engine.Finding, error) {
+	rendered, err := f.render(path)
+	if err != nil {
+		return nil, err
+	}
+	defer rendered.Remove()
+
+	if rendered.IsEmpty() {
+		return rendered.MergeFindings(findings, nil), nil
+	}
+
+	renderedFindings, err := f.engine.Run(context.Background(), rendered.Path(), rules...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rendered.MergeFindings(findings, renderedFindings), nil
+}
+
+// getRules return all enabled rules of the language, including the custom ones. When the cache
+// is enabled, each rule is wrapped on a rule that return its cached findings of files that were
+// already analyzed by it, so only new or changed files are analyzed.
+// When the manager is a PathMatcher, the rules are only executed on the files it matches.
+func (f *DefaultFormatter) getRules() []engine.Rule {
+	rules := f.getCachedRules(
+		f.removeDisabledRules(append(f.manager.GetAllRules(), f.svc.GetCustomRulesByLanguage(f.language)...)),
+	)
+
+	if matcher, ok := f.manager.(PathMatcher); ok {
+		return []engine.Rule{&pathMatcherRule{matcher: matcher, rules: rules}}
+	}
+
+	return rules
+}
+
+func (f *DefaultFormatter) getCachedRules(rules []engine.Rule) []engine.Rule {
+	if f.svc.IsCacheDisabled() {
+		return rules
+	}
+
+	engineCache, err := cache.Default()
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugCacheDisabled, err)
+		return rules
+	}
+
+	cached := make([]engine.Rule, 0, len(rules))
+	for _, rule := range rules {
+		cached = append(cached, engineCache.Rule(rule))
+	}
+
+	return cached
+}
+
+// removeDisabledRules remove the rules that were disabled on config, so they are not executed.
+func (f *DefaultFormatter) removeDisabledRules(rules []engine.Rule) []engine.Rule {
+	enabled := make([]engine.Rule, 0, len(rules))
+
+	for _, rule := range rules {
+		if r, ok := engines.TextRule(rule); ok && f.svc.IsRuleDisabled(r.ID) {
+			logger.LogDebugWithLevel(messages.MsgDebugRuleDisabled + r.ID)
+			continue
+		}
+
+		enabled = append(enabled, rule)
+	}
+
+	return enabled
+}
+
+// pathMatcherRule is an engine.Rule that run the rules only on the files matched by matcher.
+type pathMatcherRule struct {
+	matcher PathMatcher
+	rules   []engine.Rule
+}
+
+func (r *pathMatcherRule) Run(path string) ([]engine.Finding, error) {
+	if !r.matcher.MatchPath(path) {
+		return nil, nil
+	}
+
+	var findings []engine.Finding
+
+	for _, rule := range r.rules {
+		ruleFindings, err := rule.Run(path)
+		if err != nil {
+			return nil, err
+		}
+
+		findings = append(findings, ruleFindings...)
+	}
+
+	return findings, nil
+}
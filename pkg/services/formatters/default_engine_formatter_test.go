@@ -15,21 +15,36 @@
 package formatters_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	engine "github.com/ZupIT/horusec-engine"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/rulesconfig"
 	"github.com/mosajjal/horusec/pkg/services/formatters"
+	"github.com/mosajjal/horusec/pkg/services/formatters/c/horusecc"
 	"github.com/mosajjal/horusec/pkg/services/formatters/csharp/horuseccsharp"
 	"github.com/mosajjal/horusec/pkg/services/formatters/dart/horusecdart"
+	"github.com/mosajjal/horusec/pkg/services/formatters/dockerfile/horusecdockerfile"
+	"github.com/mosajjal/horusec/pkg/services/formatters/go/horusecgo"
+	"github.com/mosajjal/horusec/pkg/services/formatters/hcl/horusechcl"
 	"github.com/mosajjal/horusec/pkg/services/formatters/java/horusecjava"
 	"github.com/mosajjal/horusec/pkg/services/formatters/javascript/horusecjavascript"
 	"github.com/mosajjal/horusec/pkg/services/formatters/kotlin/horuseckotlin"
 	"github.com/mosajjal/horusec/pkg/services/formatters/leaks/horusecleaks"
 	"github.com/mosajjal/horusec/pkg/services/formatters/nginx/horusecnginx"
+	"github.com/mosajjal/horusec/pkg/services/formatters/php/horusecphp"
+	"github.com/mosajjal/horusec/pkg/services/formatters/python/horusecpython"
+	"github.com/mosajjal/horusec/pkg/services/formatters/ruby/horusecruby"
+	"github.com/mosajjal/horusec/pkg/services/formatters/shell/horusecshell"
 	"github.com/mosajjal/horusec/pkg/services/formatters/swift/horusecswift"
+	"github.com/mosajjal/horusec/pkg/services/formatters/yaml/horuseccicd"
 	"github.com/mosajjal/horusec/pkg/services/formatters/yaml/horuseckubernetes"
 	"github.com/mosajjal/horusec/pkg/utils/testutil"
 )
@@ -75,6 +90,42 @@ func TestStartAnalysis(t *testing.T) {
 			engine:    "Swift",
 			formatter: horusecswift.NewFormatter,
 		},
+		{
+			engine:    "Go",
+			formatter: horusecgo.NewFormatter,
+		},
+		{
+			engine:    "Python",
+			formatter: horusecpython.NewFormatter,
+		},
+		{
+			engine:    "Ruby",
+			formatter: horusecruby.NewFormatter,
+		},
+		{
+			engine:    "PHP",
+			formatter: horusecphp.NewFormatter,
+		},
+		{
+			engine:    "C",
+			formatter: horusecc.NewFormatter,
+		},
+		{
+			engine:    "HCL",
+			formatter: horusechcl.NewFormatter,
+		},
+		{
+			engine:    "Shell",
+			formatter: horusecshell.NewFormatter,
+		},
+		{
+			engine:    "Dockerfile",
+			formatter: horusecdockerfile.NewFormatter,
+		},
+		{
+			engine:    "CICD",
+			formatter: horuseccicd.NewFormatter,
+		},
 	}
 
 	for _, tt := range testcases {
@@ -90,6 +141,8 @@ func TestStartAnalysis(t *testing.T) {
 				service.On("GetConfigProjectPath").Return(".")
 				service.On("ParseFindingsToVulnerabilities").Return(nil)
 				service.On("GetCustomRulesByLanguage").Return([]engine.Rule{})
+				service.On("IsCacheDisabled").Return(true)
+				service.On("IsRuleDisabled").Return(false)
 
 				assert.NotPanics(t, func() {
 					tt.formatter(service).StartAnalysis("")
@@ -108,6 +161,8 @@ func TestStartAnalysis(t *testing.T) {
 				service.On("GetConfigProjectPath").Return(".")
 				service.On("ParseFindingsToVulnerabilities").Return(nil)
 				service.On("GetCustomRulesByLanguage").Return([]engine.Rule{})
+				service.On("IsCacheDisabled").Return(true)
+				service.On("IsRuleDisabled").Return(false)
 
 				assert.NotPanics(t, func() {
 					tt.formatter(service).StartAnalysis("")
@@ -126,3 +181,48 @@ func TestStartAnalysis(t *testing.T) {
 		})
 	}
 }
+
+func TestStartAnalysisWithRenderedManifests(t *testing.T) {
+	testcases := []struct {
+		name            string
+		rules           rulesconfig.RulesConfig
+		vulnerabilities int
+	}{
+		{
+			name:            "should report findings of rendered manifests on their templates",
+			vulnerabilities: 1,
+		},
+		{
+			name:            "should not report findings of rendered manifests from disabled rules",
+			rules:           rulesconfig.RulesConfig{"HS-KUBERNETES-9": {Disabled: true}},
+			vulnerabilities: 0,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.New()
+			cfg.ProjectPath = t.TempDir()
+			cfg.Rules = tt.rules
+			entity := &analysis.Analysis{ID: uuid.New()}
+
+			chartPath := filepath.Join(cfg.ProjectPath, ".horusec", entity.ID.String(), "chart")
+			require.NoError(t, os.MkdirAll(filepath.Join(chartPath, "templates"), 0o700))
+			require.NoError(t, os.WriteFile(
+				filepath.Join(chartPath, "Chart.yaml"), []byte("apiVersion: v2\nname: chart\nversion: 0.1.0\n"), 0o600,
+			))
+			require.NoError(t, os.WriteFile(
+				filepath.Join(chartPath, "templates", "pod.yaml"),
+				[]byte("kind: Pod\nspec:\n  hostNetwork: {{ .Values.hostNetwork | default true }}\n"), 0o600,
+			))
+
+			service := formatters.NewFormatterService(entity, testutil.NewDockerMock(), cfg)
+			horuseckubernetes.NewFormatter(service).StartAnalysis("")
+
+			require.Len(t, entity.AnalysisVulnerabilities, tt.vulnerabilities)
+			for _, vuln := range entity.AnalysisVulnerabilities {
+				assert.Equal(t, filepath.Join("chart", "templates", "pod.yaml"), vuln.Vulnerability.File)
+			}
+		})
+	}
+}
@@ -101,4 +101,12 @@ type IService interface {
 
 	// IsShellcheckDisable return true if shell check is disable, otherwise false.
 	IsShellcheckDisable() bool
+
+	// IsCacheDisabled return true if the cache of engines results was not enabled,
+	// otherwise false.
+	IsCacheDisabled() bool
+
+	// IsRuleDisabled return true if the rule with ruleID is disabled on config,
+	// otherwise false.
+	IsRuleDisabled(ruleID string) bool
 }
@@ -212,7 +212,15 @@ func (s *Service) ParseFindingsToVulnerabilities(findings []engine.Finding, tool
 	}
 }
 
+// AddNewVulnerabilityIntoAnalysis add the vulnerability into analysis. The severity and
+// confidence of the vulnerability are overridden by the config of its rule and vulnerabilities
+// of disabled rules are not added.
 func (s *Service) AddNewVulnerabilityIntoAnalysis(vuln *vulnerability.Vulnerability) {
+	if !s.config.Rules.Apply(vuln) {
+		logger.LogDebugWithLevel(messages.MsgDebugRuleDisabled + vuln.RuleID)
+		return
+	}
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	s.analysis.AnalysisVulnerabilities = append(s.analysis.AnalysisVulnerabilities,
@@ -282,3 +290,11 @@ func (s *Service) IsOwaspDependencyCheckDisable() bool {
 func (s *Service) IsShellcheckDisable() bool {
 	return !s.config.EnableShellCheck
 }
+
+func (s *Service) IsCacheDisabled() bool {
+	return !s.config.EnableCache
+}
+
+func (s *Service) IsRuleDisabled(ruleID string) bool {
+	return s.config.Rules.IsDisabled(ruleID)
+}
@@ -37,9 +37,11 @@ import (
 
 	"github.com/mosajjal/horusec/config"
 	dockerentities "github.com/mosajjal/horusec/pkg/entities/docker"
+	"github.com/mosajjal/horusec/pkg/entities/rulesconfig"
 	"github.com/mosajjal/horusec/pkg/entities/toolsconfig"
 	"github.com/mosajjal/horusec/pkg/entities/workdir"
 	"github.com/mosajjal/horusec/pkg/helpers/messages"
+	"github.com/mosajjal/horusec/pkg/services/engines/dockerfile"
 	"github.com/mosajjal/horusec/pkg/services/engines/java"
 	"github.com/mosajjal/horusec/pkg/utils/testutil"
 )
@@ -101,6 +103,68 @@ func TestParseFindingsToVulnerabilities(t *testing.T) {
 	}
 }
 
+func TestAddNewVulnerabilityIntoAnalysisWithRulesConfig(t *testing.T) {
+	analysis := &analysis.Analysis{
+		ID: uuid.New(),
+	}
+	cfg := config.New()
+	cfg.ProjectPath = t.TempDir()
+	cfg.Rules = rulesconfig.RulesConfig{
+		"g104":      {Disabled: true},
+		"HS-JAVA-1": {Severity: "low", Confidence: "high"},
+	}
+	svc := NewFormatterService(analysis, testutil.NewDockerMock(), cfg)
+
+	svc.AddNewVulnerabilityIntoAnalysis(&vulnerability.Vulnerability{RuleID: "G104", Severity: severities.High})
+	svc.AddNewVulnerabilityIntoAnalysis(&vulnerability.Vulnerability{
+		RuleID: "HS-JAVA-1", Severity: severities.Critical, Confidence: confidence.Low,
+	})
+	svc.AddNewVulnerabilityIntoAnalysis(&vulnerability.Vulnerability{RuleID: "HS-JAVA-2", Severity: severities.Medium})
+
+	require.Len(t, analysis.AnalysisVulnerabilities, 2)
+	assert.Equal(t, severities.Low, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+	assert.Equal(t, confidence.High, analysis.AnalysisVulnerabilities[0].Vulnerability.Confidence)
+	assert.Equal(t, severities.Medium, analysis.AnalysisVulnerabilities[1].Vulnerability.Severity)
+	assert.True(t, svc.IsRuleDisabled("G104"))
+	assert.False(t, svc.IsRuleDisabled("HS-JAVA-1"))
+}
+
+func TestDefaultFormatterRemoveDisabledRules(t *testing.T) {
+	cfg := config.New()
+	cfg.Rules = rulesconfig.RulesConfig{"hs-java-1": {Disabled: true}}
+	svc := NewFormatterService(new(analysis.Analysis), testutil.NewDockerMock(), cfg)
+	formatter := &DefaultFormatter{svc: svc}
+
+	disabled := java.NewAWSQueryInjection()
+	disabled.ID = "HS-JAVA-1"
+	enabled := java.NewAWSQueryInjection()
+	enabled.ID = "HS-JAVA-2"
+
+	rules := formatter.removeDisabledRules([]engine.Rule{disabled, enabled})
+
+	assert.Equal(t, []engine.Rule{enabled}, rules)
+}
+
+func TestDefaultFormatterPathMatcher(t *testing.T) {
+	cfg := config.New()
+	cfg.ProjectPath = t.TempDir()
+	analysis := &analysis.Analysis{ID: uuid.New()}
+
+	src := filepath.Join(cfg.ProjectPath, ".horusec", analysis.ID.String())
+	require.NoError(t, os.MkdirAll(src, 0o700))
+
+	for _, name := range []string{"Dockerfile.prod", "notes"} {
+		err := os.WriteFile(filepath.Join(src, name), []byte("FROM alpine:3.18\nUSER root\n"), 0o600)
+		require.NoError(t, err)
+	}
+
+	svc := NewFormatterService(analysis, testutil.NewDockerMock(), cfg)
+	NewDefaultFormatter(svc, dockerfile.NewRules(), languages.Generic).StartAnalysis("")
+
+	require.Len(t, analysis.AnalysisVulnerabilities, 1)
+	assert.Equal(t, "Dockerfile.prod", analysis.AnalysisVulnerabilities[0].Vulnerability.File)
+}
+
 func TestSetAnalysisError(t *testing.T) {
 	analysis := new(analysis.Analysis)
 	svc := NewFormatterService(analysis, testutil.NewDockerMock(), config.New())
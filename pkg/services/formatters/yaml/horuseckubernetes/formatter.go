@@ -19,8 +19,21 @@ import (
 
 	"github.com/mosajjal/horusec/pkg/services/engines/kubernetes"
 	"github.com/mosajjal/horusec/pkg/services/formatters"
+	"github.com/mosajjal/horusec/pkg/services/manifests"
 )
 
+// NewFormatter create a formatter that run the Kubernetes rules on the project files and on
+// the manifests rendered from its Helm charts and Kustomize overlays, so the findings of the
+// final manifests are reported on the template or patch that produced them.
 func NewFormatter(service formatters.IService) formatters.IFormatter {
-	return formatters.NewDefaultFormatter(service, kubernetes.NewRules(), languages.Yaml)
+	return formatters.NewDefaultFormatterWithRender(service, kubernetes.NewRules(), languages.Yaml, render)
+}
+
+func render(path string) (formatters.Rendered, error) {
+	rendered, err := manifests.Render(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return rendered, nil
 }
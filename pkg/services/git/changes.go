@@ -0,0 +1,189 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+const defaultChangesReference = "HEAD"
+
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// LineRange is an interval of changed lines, where Start and End are inclusive.
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Changes contains the files changed since a git reference, relative to the project
+// path, and the lines that was changed on each one of them. Files that are not
+// tracked by git don't have line ranges, since all of its lines are new.
+type Changes map[string][]LineRange
+
+// ContainsFile return true if the file, relative to the project path, was changed.
+func (c Changes) ContainsFile(path string) bool {
+	_, exists := c[filepath.ToSlash(path)]
+	return exists
+}
+
+// ContainsPath return true if path, relative to the project path, is a changed file
+// or is a directory that contains at least one changed file.
+func (c Changes) ContainsPath(path string) bool {
+	path = filepath.ToSlash(path)
+	if path == "." || path == "" || c.ContainsFile(path) {
+		return true
+	}
+
+	for file := range c {
+		if strings.HasPrefix(file, path+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsLine return true if the line of the file, relative to the project path,
+// was changed.
+func (c Changes) ContainsLine(path string, line int) bool {
+	ranges, exists := c[filepath.ToSlash(path)]
+	if !exists {
+		return false
+	}
+
+	if ranges == nil {
+		return true
+	}
+
+	for _, lines := range ranges {
+		if line >= lines.Start && line <= lines.End {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Changes return the files and lines of the project path changed since the git
+// reference from config, including the changes that are not commited yet and the
+// files not tracked by git. If no reference was set, the changes are computed
+// from HEAD, so only uncommitted changes will be returned.
+func (g *Git) Changes() (Changes, error) {
+	reference := g.config.Since
+	if reference == "" {
+		reference = defaultChangesReference
+	}
+
+	diff, err := g.executeChangesCMD("diff", "--unified=0", "--no-color", "--no-ext-diff", "--no-prefix",
+		"--relative", reference, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	untracked, err := g.executeChangesCMD("ls-files", "-z", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+
+	changes := g.parseDiffOutput(diff)
+	for _, file := range strings.Split(string(untracked), "\x00") {
+		if file != "" {
+			changes[file] = nil
+		}
+	}
+
+	return changes, nil
+}
+
+func (g *Git) executeChangesCMD(args ...string) ([]byte, error) {
+	stderr := bytes.NewBufferString("")
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.config.ProjectPath
+	cmd.Stderr = stderr
+
+	response, err := cmd.Output()
+	if err != nil {
+		logger.LogErrorWithLevel(
+			messages.MsgErrorGitChangedFilesExecute, err,
+			map[string]interface{}{
+				"args":   strings.Join(args, " "),
+				"stderr": stderr.String(),
+			})
+	}
+
+	return response, err
+}
+
+// parseDiffOutput parse the output of git diff with zero lines of context, where
+// each changed file starts with a "+++ path" line, followed by the hunk headers
+// that contains the range of lines that was added or changed on the new file.
+func (g *Git) parseDiffOutput(output []byte) Changes {
+	changes := make(Changes)
+	file := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "+++ ") {
+			file = g.parseDiffFilename(strings.TrimPrefix(line, "+++ "))
+			continue
+		}
+
+		matches := hunkHeaderRegex.FindStringSubmatch(line)
+		if file == "" || matches == nil {
+			continue
+		}
+
+		start, _ := strconv.Atoi(matches[1])
+		count := 1
+		if matches[2] != "" {
+			count, _ = strconv.Atoi(matches[2])
+		}
+
+		if count > 0 {
+			changes[file] = append(changes[file], LineRange{Start: start, End: start + count - 1})
+		}
+	}
+
+	return changes
+}
+
+// parseDiffFilename parse the filename of a "+++" line, which git quotes like a C string
+// when it contains special characters, e.g. "caf\303\251.go".
+func (g *Git) parseDiffFilename(name string) string {
+	name = strings.TrimSuffix(name, "\t")
+	if name == "/dev/null" {
+		return ""
+	}
+
+	if unquoted, err := strconv.Unquote(name); err == nil {
+		return unquoted
+	}
+
+	return name
+}
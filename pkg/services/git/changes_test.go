@@ -0,0 +1,107 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosajjal/horusec/config"
+)
+
+func TestChanges(t *testing.T) {
+	repository := t.TempDir()
+	runGit(t, repository, "init", "--quiet")
+	writeFile(t, repository, "main.go", "package main\n\nfunc main() {\n}\n")
+	writeFile(t, repository, "unchanged.go", "package main\n")
+	writeFile(t, repository, "café.go", "package main\n")
+	runGit(t, repository, "add", "--all")
+	runGit(t, repository, "commit", "--quiet", "--message", "first commit")
+
+	writeFile(t, repository, "main.go", "package main\n\nimport \"os\"\n\nfunc main() {\n\tos.Exit(1)\n}\n")
+	writeFile(t, repository, filepath.Join("pkg", "new.go"), "package pkg\n")
+	writeFile(t, repository, "café.go", "package main\n\nvar x = 1\n")
+	writeFile(t, repository, "new \"file\".go", "package main\n")
+
+	t.Run("Should return changed lines and untracked files since HEAD", func(t *testing.T) {
+		changes, err := New(&config.Config{StartOptions: config.StartOptions{ProjectPath: repository}}).Changes()
+		require.NoError(t, err)
+
+		assert.True(t, changes.ContainsFile("main.go"))
+		assert.False(t, changes.ContainsFile("unchanged.go"))
+		assert.True(t, changes.ContainsLine("main.go", 3))
+		assert.True(t, changes.ContainsLine("main.go", 6))
+		assert.False(t, changes.ContainsLine("main.go", 1))
+		assert.True(t, changes.ContainsLine(filepath.Join("pkg", "new.go"), 1))
+		assert.True(t, changes.ContainsPath("pkg"))
+		assert.False(t, changes.ContainsPath("vendor"))
+	})
+
+	t.Run("Should return quoted filenames unquoted", func(t *testing.T) {
+		changes, err := New(&config.Config{StartOptions: config.StartOptions{ProjectPath: repository}}).Changes()
+		require.NoError(t, err)
+
+		assert.True(t, changes.ContainsLine("café.go", 3))
+		assert.False(t, changes.ContainsLine("café.go", 1))
+		assert.True(t, changes.ContainsFile("new \"file\".go"))
+	})
+
+	t.Run("Should return error when reference does not exists", func(t *testing.T) {
+		cfg := &config.Config{StartOptions: config.StartOptions{ProjectPath: repository, Since: "invalid-reference"}}
+
+		_, err := New(cfg).Changes()
+		assert.Error(t, err)
+	})
+}
+
+func TestParseDiffOutput(t *testing.T) {
+	t.Run("Should parse added, changed and removed lines from diff output", func(t *testing.T) {
+		output := []byte(`diff --git app.py app.py
+--- app.py
++++ app.py
+@@ -1,0 +2,3 @@ import os
+@@ -10 +13 @@ def main():
+@@ -20,2 +22,0 @@ def run():
+diff --git removed.py removed.py
+--- removed.py
++++ /dev/null
+@@ -1,5 +0,0 @@
+`)
+
+		changes := New(&config.Config{}).parseDiffOutput(output)
+
+		assert.Equal(t, Changes{"app.py": {{Start: 2, End: 4}, {Start: 13, End: 13}}}, changes)
+	})
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=horusec", "-c", "user.email=horusec@zup.com.br"},
+		args...)...)
+	cmd.Dir = dir
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
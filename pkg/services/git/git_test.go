@@ -25,13 +25,16 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mosajjal/horusec/config"
-	"github.com/mosajjal/horusec/pkg/utils/testutil"
 )
 
+// rootPath is the root directory of horusec repository. The testutil package is not
+// used, since it imports this package on its mocks.
+var rootPath, _ = filepath.Abs(filepath.Join("..", "..", ".."))
+
 func TestGetCommitAuthor(t *testing.T) {
 	cfg := &config.Config{
 		StartOptions: config.StartOptions{
-			ProjectPath:        testutil.RootPath,
+			ProjectPath:        rootPath,
 			EnableCommitAuthor: true,
 		},
 	}
@@ -81,7 +84,7 @@ func TestGetCommitAuthor(t *testing.T) {
 	t.Run("Should return commit author not found when disable commit author", func(t *testing.T) {
 		cfg := &config.Config{
 			StartOptions: config.StartOptions{
-				ProjectPath: testutil.RootPath,
+				ProjectPath: rootPath,
 			},
 		}
 		author := New(cfg).CommitAuthor("1-2", "README.md")
@@ -126,7 +129,7 @@ func TestRepositoryIsShallow(t *testing.T) {
 		"git",
 		"clone",
 		"--depth=1",
-		fmt.Sprintf("file://%s", testutil.RootPath),
+		fmt.Sprintf("file://%s", rootPath),
 		shallowRepository,
 	).Output()
 	require.Nil(t, err, "Expected nil error to shallow clone repository: %v", err)
@@ -148,7 +151,7 @@ func TestRepositoryIsShallow(t *testing.T) {
 			name: "NotShallow",
 			cfg: &config.Config{
 				StartOptions: config.StartOptions{
-					ProjectPath: testutil.RootPath,
+					ProjectPath: rootPath,
 				},
 			},
 			expected: false,
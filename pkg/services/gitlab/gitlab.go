@@ -0,0 +1,256 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	analysisenum "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/google/uuid"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+	vulninfo "github.com/mosajjal/horusec/pkg/utils/vuln_info"
+)
+
+const (
+	schemaVersion          = "15.0.6"
+	scanTypeSAST           = "sast"
+	scanTypeDependency     = "dependency_scanning"
+	scannerID              = "horusec"
+	scannerName            = "Horusec"
+	timeLayout             = "2006-01-02T15:04:05"
+	statusSuccess          = "success"
+	statusFailure          = "failure"
+	identifierTypeRuleID   = "horusec_rule_id"
+	identifierTypeTool     = "horusec_tool"
+	identifierTypeCWE      = "cwe"
+	identifierTypeCVE      = "cve"
+	cweURL                 = "https://cwe.mitre.org/data/definitions/%s.html"
+	cveURL                 = "https://cve.mitre.org/cgi-bin/cvename.cgi?name="
+	maxVulnerabilityLength = 255
+)
+
+var (
+	cweRegex = regexp.MustCompile(`CWE-(\d+)`)
+	cveRegex = regexp.MustCompile(`CVE-\d{4}-\d+`)
+)
+
+// scaTools are the tools that analyze dependencies, their vulnerabilities are
+// reported on the Dependency Scanning report instead of the SAST report. Trivy also
+// reports misconfigurations of IaC files, see IsSCA.
+var scaTools = map[tools.Tool]bool{
+	tools.Nancy:        true,
+	tools.NpmAudit:     true,
+	tools.YarnAudit:    true,
+	tools.Safety:       true,
+	tools.BundlerAudit: true,
+	tools.Trivy:        true,
+}
+
+type GitLab struct {
+	analysis      *analysis.Analysis
+	version       string
+	fingerprinter *vulnhash.Fingerprinter
+}
+
+func NewGitLab(entity *analysis.Analysis, version, projectPath string) *GitLab {
+	return &GitLab{
+		analysis:      entity,
+		version:       version,
+		fingerprinter: vulnhash.NewFingerprinter(projectPath),
+	}
+}
+
+// IsSCA return true if the vulnerability is on a dependency of the project. Trivy reports
+// misconfigurations of IaC files without a vulnerability id, that are not on dependencies.
+func IsSCA(vuln *vulnerability.Vulnerability) bool {
+	if vuln.SecurityTool == tools.Trivy && vuln.RuleID == "" {
+		return false
+	}
+
+	return scaTools[vuln.SecurityTool]
+}
+
+// ConvertVulnerabilityToSAST convert the vulnerabilities that are not on dependencies to
+// the GitLab SAST report schema.
+func (g *GitLab) ConvertVulnerabilityToSAST() Report {
+	report := g.newReport(scanTypeSAST)
+	for index := range g.analysis.AnalysisVulnerabilities {
+		vuln := g.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if IsSCA(&vuln) {
+			continue
+		}
+
+		report.Vulnerabilities = append(report.Vulnerabilities, g.newVulnerability(&vuln, g.newSASTLocation(&vuln)))
+	}
+
+	return report
+}
+
+// ConvertVulnerabilityToDependencyScanning convert the vulnerabilities on dependencies to
+// the GitLab Dependency Scanning report schema.
+func (g *GitLab) ConvertVulnerabilityToDependencyScanning() Report {
+	report := g.newReport(scanTypeDependency)
+	for index := range g.analysis.AnalysisVulnerabilities {
+		vuln := g.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if !IsSCA(&vuln) {
+			continue
+		}
+
+		report.Vulnerabilities = append(report.Vulnerabilities, g.newVulnerability(&vuln, g.newDependencyLocation(&vuln)))
+	}
+
+	return report
+}
+
+func (g *GitLab) newReport(scanType string) Report {
+	scanner := Scanner{
+		ID:      scannerID,
+		Name:    scannerName,
+		Version: g.version,
+		Vendor:  Vendor{Name: scannerName},
+	}
+
+	return Report{
+		Version:         schemaVersion,
+		Vulnerabilities: []Vulnerability{},
+		Scan: Scan{
+			Analyzer:  scanner,
+			Scanner:   scanner,
+			Type:      scanType,
+			StartTime: g.analysis.CreatedAt.Format(timeLayout),
+			EndTime:   g.analysis.FinishedAt.Format(timeLayout),
+			Status:    g.getStatus(),
+		},
+	}
+}
+
+func (g *GitLab) getStatus() string {
+	if g.analysis.Status == analysisenum.Error {
+		return statusFailure
+	}
+
+	return statusSuccess
+}
+
+func (g *GitLab) newVulnerability(vuln *vulnerability.Vulnerability, location Location) Vulnerability {
+	return Vulnerability{
+		ID:          g.getID(vuln),
+		Name:        vulninfo.Title(vuln, maxVulnerabilityLength),
+		Description: vuln.Details,
+		Severity:    g.convertHorusecSeverityToGitLab(vuln.Severity),
+		Identifiers: g.getIdentifiers(vuln),
+		Location:    location,
+	}
+}
+
+// getID return an UUID generated from the vulnerability fingerprint and line, so the same
+// vulnerability has the same id between analyses. The line is required since the same code
+// on distinct lines of a file, e.g. a copied snippet, has the same fingerprint, and GitLab
+// expects the ids to be unique on a report.
+func (g *GitLab) getID(vuln *vulnerability.Vulnerability) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(g.fingerprinter.Fingerprint(vuln)+":"+vuln.Line)).String()
+}
+
+func (g *GitLab) getIdentifiers(vuln *vulnerability.Vulnerability) []Identifier {
+	identifiers := []Identifier{g.getRuleIdentifier(vuln)}
+
+	for _, match := range cweRegex.FindAllStringSubmatch(vuln.Details, -1) {
+		identifiers = appendIdentifier(identifiers, Identifier{
+			Type:  identifierTypeCWE,
+			Name:  match[0],
+			Value: match[1],
+			URL:   fmt.Sprintf(cweURL, match[1]),
+		})
+	}
+
+	for _, match := range cveRegex.FindAllString(vuln.Details, -1) {
+		identifiers = appendIdentifier(identifiers, Identifier{
+			Type:  identifierTypeCVE,
+			Name:  match,
+			Value: match,
+			URL:   cveURL + match,
+		})
+	}
+
+	return identifiers
+}
+
+// getRuleIdentifier return the identifier of the rule that found the vulnerability, that
+// is the security tool when the vulnerability has no rule id.
+func (g *GitLab) getRuleIdentifier(vuln *vulnerability.Vulnerability) Identifier {
+	identifierType := identifierTypeRuleID
+	if vuln.RuleID == "" {
+		identifierType = identifierTypeTool
+	}
+
+	name := vulninfo.RuleName(vuln)
+
+	return Identifier{Type: identifierType, Name: name, Value: name}
+}
+
+func (g *GitLab) newSASTLocation(vuln *vulnerability.Vulnerability) Location {
+	start, end := vulninfo.LineRange(vuln.Line)
+
+	return Location{
+		File:      vuln.File,
+		StartLine: start,
+		EndLine:   end,
+	}
+}
+
+func (g *GitLab) newDependencyLocation(vuln *vulnerability.Vulnerability) Location {
+	return Location{
+		File: vuln.File,
+		Dependency: &Dependency{
+			Package: Package{Name: strings.TrimSpace(vuln.Code)},
+		},
+	}
+}
+
+func (g *GitLab) convertHorusecSeverityToGitLab(severity severities.Severity) string {
+	if value, ok := g.getGitLabSeverityMap()[severity]; ok {
+		return value
+	}
+
+	return "Unknown"
+}
+
+func (g *GitLab) getGitLabSeverityMap() map[severities.Severity]string {
+	return map[severities.Severity]string{
+		severities.Critical: "Critical",
+		severities.High:     "High",
+		severities.Medium:   "Medium",
+		severities.Low:      "Low",
+		severities.Unknown:  "Unknown",
+		severities.Info:     "Info",
+	}
+}
+
+func appendIdentifier(identifiers []Identifier, identifier Identifier) []Identifier {
+	for _, existing := range identifiers {
+		if existing.Type == identifier.Type && existing.Value == identifier.Value {
+			return identifiers
+		}
+	}
+
+	return append(identifiers, identifier)
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumHorusec "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
+)
+
+func newAnalysisMock() *analysis.Analysis {
+	return &analysis.Analysis{
+		ID:         uuid.New(),
+		CreatedAt:  time.Date(2021, 12, 30, 10, 0, 0, 0, time.UTC),
+		FinishedAt: time.Date(2021, 12, 30, 10, 5, 0, 0, time.UTC),
+		Status:     enumHorusec.Success,
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			{
+				Vulnerability: vulnerability.Vulnerability{
+					VulnerabilityID: uuid.MustParse("54a7a2a9-d68e-4139-ba53-6bff3bc84863"),
+					RuleID:          "HS-GO-3",
+					File:            "main.go",
+					Line:            "10",
+					Details:         "Weak Hashing Function\nFor more information checkout the CWE-328 advisory.",
+					Severity:        severities.Medium,
+					SecurityTool:    tools.HorusecEngine,
+				},
+			},
+			{
+				Vulnerability: vulnerability.Vulnerability{
+					File:         "go.mod",
+					Code:         "github.com/gin-gonic/gin v1.6.0 ",
+					Details:      "CVE-2020-28483 in github.com/gin-gonic/gin",
+					Severity:     severities.High,
+					SecurityTool: tools.Nancy,
+				},
+			},
+			{
+				Vulnerability: vulnerability.Vulnerability{
+					File:         "deployment.yaml",
+					Line:         "10-12",
+					Code:         "Deployment\nCPU not limited",
+					Details:      "MissConfiguration\n      Enforcing CPU limits prevents DoS via resource exhaustion.",
+					Severity:     severities.Low,
+					SecurityTool: tools.Trivy,
+				},
+			},
+		},
+	}
+}
+
+func TestConvertVulnerabilityToSAST(t *testing.T) {
+	t.Run("should convert only vulnerabilities not on dependencies", func(t *testing.T) {
+		entity := newAnalysisMock()
+		report := NewGitLab(entity, "v2.0.0", t.TempDir()).ConvertVulnerabilityToSAST()
+
+		assert.Equal(t, schemaVersion, report.Version)
+		assert.Equal(t, scanTypeSAST, report.Scan.Type)
+		assert.Equal(t, "2021-12-30T10:00:00", report.Scan.StartTime)
+		assert.Equal(t, "2021-12-30T10:05:00", report.Scan.EndTime)
+		assert.Equal(t, statusSuccess, report.Scan.Status)
+		assert.Equal(t, "v2.0.0", report.Scan.Scanner.Version)
+		require.Len(t, report.Vulnerabilities, 2)
+
+		vuln := report.Vulnerabilities[0]
+		fingerprint := vulnhash.Fingerprint(&entity.AnalysisVulnerabilities[0].Vulnerability, nil)
+		assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceOID, []byte(fingerprint+":10")).String(), vuln.ID)
+		assert.Equal(t, "Weak Hashing Function", vuln.Name)
+		assert.Equal(t, "Medium", vuln.Severity)
+		assert.Equal(t, Location{File: "main.go", StartLine: 10, EndLine: 10}, vuln.Location)
+		assert.Equal(t, []Identifier{
+			{Type: identifierTypeRuleID, Name: "HS-GO-3", Value: "HS-GO-3"},
+			{Type: identifierTypeCWE, Name: "CWE-328", Value: "328", URL: "https://cwe.mitre.org/data/definitions/328.html"},
+		}, vuln.Identifiers)
+	})
+
+	t.Run("should convert misconfigurations of trivy with the range of lines", func(t *testing.T) {
+		report := NewGitLab(newAnalysisMock(), "v2.0.0", t.TempDir()).ConvertVulnerabilityToSAST()
+		require.Len(t, report.Vulnerabilities, 2)
+
+		vuln := report.Vulnerabilities[1]
+		assert.Equal(t, "MissConfiguration", vuln.Name)
+		assert.Equal(t, Location{File: "deployment.yaml", StartLine: 10, EndLine: 12}, vuln.Location)
+	})
+
+	t.Run("should generate the same id for the same vulnerability between analyses", func(t *testing.T) {
+		report := NewGitLab(newAnalysisMock(), "v2.0.0", t.TempDir()).ConvertVulnerabilityToSAST()
+		other := NewGitLab(newAnalysisMock(), "v2.0.0", t.TempDir()).ConvertVulnerabilityToSAST()
+
+		assert.Equal(t, report.Vulnerabilities[0].ID, other.Vulnerabilities[0].ID)
+		assert.NotEqual(t, report.Vulnerabilities[0].ID, report.Vulnerabilities[1].ID)
+	})
+
+	t.Run("should generate distinct ids for the same code on distinct lines", func(t *testing.T) {
+		entity := newAnalysisMock()
+		copied := entity.AnalysisVulnerabilities[0]
+		copied.Vulnerability.Line = "20"
+		entity.AnalysisVulnerabilities = append(entity.AnalysisVulnerabilities, copied)
+
+		report := NewGitLab(entity, "v2.0.0", t.TempDir()).ConvertVulnerabilityToSAST()
+		require.Len(t, report.Vulnerabilities, 3)
+
+		assert.NotEqual(t, report.Vulnerabilities[0].ID, report.Vulnerabilities[2].ID)
+	})
+
+	t.Run("should return failure status and empty vulnerabilities", func(t *testing.T) {
+		report := NewGitLab(&analysis.Analysis{Status: enumHorusec.Error}, "", "").ConvertVulnerabilityToSAST()
+
+		assert.Equal(t, statusFailure, report.Scan.Status)
+		assert.NotNil(t, report.Vulnerabilities)
+		assert.Empty(t, report.Vulnerabilities)
+	})
+}
+
+func TestConvertVulnerabilityToDependencyScanning(t *testing.T) {
+	t.Run("should convert only vulnerabilities on dependencies", func(t *testing.T) {
+		report := NewGitLab(newAnalysisMock(), "v2.0.0", t.TempDir()).ConvertVulnerabilityToDependencyScanning()
+
+		assert.Equal(t, scanTypeDependency, report.Scan.Type)
+		require.Len(t, report.Vulnerabilities, 1)
+
+		vuln := report.Vulnerabilities[0]
+		assert.NotEmpty(t, vuln.ID)
+		assert.Equal(t, "High", vuln.Severity)
+		assert.Equal(t, "go.mod", vuln.Location.File)
+		require.NotNil(t, vuln.Location.Dependency)
+		assert.Equal(t, "github.com/gin-gonic/gin v1.6.0", vuln.Location.Dependency.Package.Name)
+		assert.Equal(t, []Identifier{
+			{Type: identifierTypeTool, Name: "Nancy", Value: "Nancy"},
+			{
+				Type: identifierTypeCVE, Name: "CVE-2020-28483", Value: "CVE-2020-28483",
+				URL: "https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2020-28483",
+			},
+		}, vuln.Identifiers)
+	})
+}
+
+func TestIsSCA(t *testing.T) {
+	t.Run("should return true only for vulnerabilities of sca tools", func(t *testing.T) {
+		for _, tool := range []tools.Tool{
+			tools.Nancy, tools.NpmAudit, tools.YarnAudit, tools.Safety, tools.BundlerAudit,
+		} {
+			assert.True(t, IsSCA(&vulnerability.Vulnerability{SecurityTool: tool}), tool)
+		}
+
+		assert.False(t, IsSCA(&vulnerability.Vulnerability{SecurityTool: tools.HorusecEngine}))
+		assert.False(t, IsSCA(&vulnerability.Vulnerability{SecurityTool: tools.GoSec}))
+	})
+
+	t.Run("should return true only for vulnerabilities of trivy with vulnerability id", func(t *testing.T) {
+		assert.True(t, IsSCA(&vulnerability.Vulnerability{SecurityTool: tools.Trivy, RuleID: "CVE-2020-28483"}))
+		assert.False(t, IsSCA(&vulnerability.Vulnerability{SecurityTool: tools.Trivy}))
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+// Report is the GitLab security report used by both SAST and Dependency Scanning schemas.
+type Report struct {
+	Version         string          `json:"version"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+	Scan            Scan            `json:"scan"`
+}
+
+type Vulnerability struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Severity    string       `json:"severity"`
+	Identifiers []Identifier `json:"identifiers"`
+	Location    Location     `json:"location"`
+}
+
+type Identifier struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+// Location is the location of a vulnerability. SAST reports use the file and lines and
+// Dependency Scanning reports use the file and the vulnerable dependency.
+type Location struct {
+	File       string      `json:"file"`
+	StartLine  int         `json:"start_line,omitempty"`
+	EndLine    int         `json:"end_line,omitempty"`
+	Dependency *Dependency `json:"dependency,omitempty"`
+}
+
+type Dependency struct {
+	Package Package `json:"package"`
+}
+
+type Package struct {
+	Name string `json:"name"`
+}
+
+type Scan struct {
+	Analyzer  Scanner `json:"analyzer"`
+	Scanner   Scanner `json:"scanner"`
+	Type      string  `json:"type"`
+	StartTime string  `json:"start_time"`
+	EndTime   string  `json:"end_time"`
+	Status    string  `json:"status"`
+}
+
+type Scanner struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Vendor  Vendor `json:"vendor"`
+}
+
+type Vendor struct {
+	Name string `json:"name"`
+}
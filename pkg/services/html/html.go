@@ -0,0 +1,223 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	_ "embed" // used to embed the report template
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// timeLayout is the layout of the analysis dates on report.
+const timeLayout = "2006-01-02 15:04:05"
+
+//go:embed report.html
+var reportTemplate string
+
+var reportTmpl = template.Must(template.New("report").Parse(reportTemplate))
+
+// HTML generates a self-contained HTML report of an analysis, with all styles
+// and scripts embedded on the file, so it can be opened without internet access.
+type HTML struct {
+	analysis         *analysis.Analysis
+	version          string
+	showCommitAuthor bool
+}
+
+// Report is the data used to render the HTML report.
+type Report struct {
+	Version          string
+	AnalysisID       string
+	Status           string
+	CreatedAt        string
+	FinishedAt       string
+	Total            int
+	ShowCommitAuthor bool
+	Severities       []ChartItem
+	Tools            []ChartItem
+	Languages        []string
+	Types            []string
+	Vulnerabilities  []Vulnerability
+	Errors           []string
+	Warnings         []string
+}
+
+// ChartItem is a bar of a chart of the report, with the percent relative to the total.
+type ChartItem struct {
+	Label   string
+	Count   int
+	Percent int
+}
+
+// Vulnerability is a vulnerability shown on the report.
+type Vulnerability struct {
+	Severity      string
+	Type          string
+	Tool          string
+	Language      string
+	RuleID        string
+	Confidence    string
+	File          string
+	Line          string
+	Column        string
+	Code          string
+	Details       string
+	VulnHash      string
+	CommitAuthor  string
+	CommitEmail   string
+	CommitHash    string
+	CommitDate    string
+	CommitMessage string
+}
+
+// NewHTML create a new HTML report of the analysis. The commit authors of vulnerabilities
+// are only shown when showCommitAuthor is true.
+func NewHTML(entity *analysis.Analysis, version string, showCommitAuthor bool) *HTML {
+	return &HTML{
+		analysis:         entity,
+		version:          version,
+		showCommitAuthor: showCommitAuthor,
+	}
+}
+
+// Write render the HTML report of the analysis on writer.
+func (h *HTML) Write(writer io.Writer) error {
+	return reportTmpl.Execute(writer, h.Report())
+}
+
+// Report return the data used to render the HTML report.
+func (h *HTML) Report() *Report {
+	report := &Report{
+		Version:          h.version,
+		AnalysisID:       h.analysis.ID.String(),
+		Status:           h.analysis.Status.ToString(),
+		CreatedAt:        h.analysis.CreatedAt.Format(timeLayout),
+		FinishedAt:       h.analysis.FinishedAt.Format(timeLayout),
+		Total:            len(h.analysis.AnalysisVulnerabilities),
+		ShowCommitAuthor: h.showCommitAuthor,
+		Errors:           h.errors(),
+		Warnings:         h.analysis.Warnings,
+	}
+
+	bySeverity := make(map[string]int)
+	byTool := make(map[string]int)
+	languages := make(map[string]bool)
+	types := make(map[string]bool)
+
+	for index := range h.analysis.AnalysisVulnerabilities {
+		vuln := h.newVulnerability(&h.analysis.AnalysisVulnerabilities[index])
+		report.Vulnerabilities = append(report.Vulnerabilities, vuln)
+
+		bySeverity[vuln.Severity]++
+		byTool[vuln.Tool]++
+		languages[vuln.Language] = true
+		types[vuln.Type] = true
+	}
+
+	report.Severities = h.severitiesChart(bySeverity, report.Total)
+	report.Tools = h.toolsChart(byTool, report.Total)
+	report.Languages = sortedKeys(languages)
+	report.Types = sortedKeys(types)
+
+	return report
+}
+
+func (h *HTML) newVulnerability(entity *analysis.AnalysisVulnerabilities) Vulnerability {
+	vuln := entity.Vulnerability
+
+	return Vulnerability{
+		Severity:      vuln.Severity.ToString(),
+		Type:          vuln.Type.ToString(),
+		Tool:          vuln.SecurityTool.ToString(),
+		Language:      vuln.Language.ToString(),
+		RuleID:        vuln.RuleID,
+		Confidence:    vuln.Confidence.ToString(),
+		File:          vuln.File,
+		Line:          vuln.Line,
+		Column:        vuln.Column,
+		Code:          vuln.Code,
+		Details:       vuln.Details,
+		VulnHash:      vuln.VulnHash,
+		CommitAuthor:  vuln.CommitAuthor,
+		CommitEmail:   vuln.CommitEmail,
+		CommitHash:    vuln.CommitHash,
+		CommitDate:    vuln.CommitDate,
+		CommitMessage: vuln.CommitMessage,
+	}
+}
+
+// severitiesChart return the chart of vulnerabilities by severity, ordered
+// from the most to the least severe and including severities without vulnerabilities.
+func (h *HTML) severitiesChart(bySeverity map[string]int, total int) []ChartItem {
+	chart := make([]ChartItem, 0, len(severities.Values()))
+	for _, severity := range severities.Values() {
+		chart = append(chart, newChartItem(severity.ToString(), bySeverity[severity.ToString()], total))
+	}
+
+	return chart
+}
+
+// toolsChart return the chart of vulnerabilities by tool ordered by the total of vulnerabilities.
+func (h *HTML) toolsChart(byTool map[string]int, total int) []ChartItem {
+	chart := make([]ChartItem, 0, len(byTool))
+	for tool, count := range byTool {
+		chart = append(chart, newChartItem(tool, count, total))
+	}
+
+	sort.SliceStable(chart, func(i, j int) bool {
+		if chart[i].Count == chart[j].Count {
+			return chart[i].Label < chart[j].Label
+		}
+
+		return chart[i].Count > chart[j].Count
+	})
+
+	return chart
+}
+
+func (h *HTML) errors() (errors []string) {
+	for _, err := range strings.Split(h.analysis.Errors, ";") {
+		if err = strings.TrimSpace(err); err != "" {
+			errors = append(errors, err)
+		}
+	}
+
+	return errors
+}
+
+func newChartItem(label string, count, total int) ChartItem {
+	item := ChartItem{Label: label, Count: count}
+	if total > 0 {
+		item.Percent = count * 100 / total
+	}
+
+	return item
+}
+
+func sortedKeys(values map[string]bool) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
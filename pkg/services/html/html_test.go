@@ -0,0 +1,148 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumHorusec "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityenum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAnalysisMock() *analysis.Analysis {
+	return &analysis.Analysis{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Status:    enumHorusec.Success,
+		Errors:    "first error; second error",
+		Warnings:  []string{"some warning"},
+		AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+			{
+				Vulnerability: vulnerability.Vulnerability{
+					RuleID:       "HS-GO-3",
+					File:         "main.go",
+					Line:         "10",
+					Code:         `<script>alert("xss")</script>`,
+					Severity:     severities.High,
+					SecurityTool: tools.HorusecEngine,
+					Language:     languages.Go,
+					Type:         vulnerabilityenum.Vulnerability,
+					CommitAuthor: "horusec-author",
+				},
+			},
+			{
+				Vulnerability: vulnerability.Vulnerability{
+					File:         "go.mod",
+					Severity:     severities.Low,
+					SecurityTool: tools.GoSec,
+					Language:     languages.Go,
+					Type:         vulnerabilityenum.RiskAccepted,
+				},
+			},
+			{
+				Vulnerability: vulnerability.Vulnerability{
+					File:         "main.go",
+					Severity:     severities.High,
+					SecurityTool: tools.HorusecEngine,
+					Language:     languages.Go,
+					Type:         vulnerabilityenum.Vulnerability,
+				},
+			},
+		},
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Run("should group vulnerabilities by severity and tool", func(t *testing.T) {
+		report := NewHTML(newAnalysisMock(), "v1.0.0", false).Report()
+
+		assert.Equal(t, 3, report.Total)
+		assert.Len(t, report.Vulnerabilities, 3)
+		assert.Len(t, report.Severities, len(severities.Values()))
+		assert.Equal(t, ChartItem{Label: severities.High.ToString(), Count: 2, Percent: 66}, report.Severities[1])
+		assert.Equal(t, ChartItem{Label: severities.Low.ToString(), Count: 1, Percent: 33}, report.Severities[3])
+		assert.Equal(t, []ChartItem{
+			{Label: tools.HorusecEngine.ToString(), Count: 2, Percent: 66},
+			{Label: tools.GoSec.ToString(), Count: 1, Percent: 33},
+		}, report.Tools)
+		assert.Equal(t, []string{languages.Go.ToString()}, report.Languages)
+		assert.Equal(t, []string{
+			vulnerabilityenum.RiskAccepted.ToString(), vulnerabilityenum.Vulnerability.ToString(),
+		}, report.Types)
+	})
+
+	t.Run("should split analysis errors and keep warnings", func(t *testing.T) {
+		report := NewHTML(newAnalysisMock(), "v1.0.0", false).Report()
+
+		assert.Equal(t, []string{"first error", "second error"}, report.Errors)
+		assert.Equal(t, []string{"some warning"}, report.Warnings)
+	})
+
+	t.Run("should not divide by zero without vulnerabilities", func(t *testing.T) {
+		report := NewHTML(&analysis.Analysis{}, "v1.0.0", false).Report()
+
+		assert.Zero(t, report.Total)
+		assert.Empty(t, report.Tools)
+		assert.Empty(t, report.Errors)
+		for _, item := range report.Severities {
+			assert.Zero(t, item.Percent)
+		}
+	})
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("should render a self-contained html report", func(t *testing.T) {
+		var output bytes.Buffer
+
+		require.NoError(t, NewHTML(newAnalysisMock(), "v1.0.0", false).Write(&output))
+
+		html := output.String()
+		assert.Contains(t, html, "<style>")
+		assert.Contains(t, html, "<script>")
+		assert.NotContains(t, html, "http://")
+		assert.NotContains(t, html, "https://")
+		assert.Contains(t, html, "HS-GO-3")
+		assert.Contains(t, html, "first error")
+		assert.Contains(t, html, "some warning")
+		assert.NotContains(t, html, "horusec-author")
+	})
+
+	t.Run("should escape vulnerability code", func(t *testing.T) {
+		var output bytes.Buffer
+
+		require.NoError(t, NewHTML(newAnalysisMock(), "v1.0.0", false).Write(&output))
+
+		assert.NotContains(t, output.String(), `<script>alert("xss")</script>`)
+		assert.Contains(t, output.String(), "&lt;script&gt;")
+	})
+
+	t.Run("should render commit authors when enabled", func(t *testing.T) {
+		var output bytes.Buffer
+
+		require.NoError(t, NewHTML(newAnalysisMock(), "v1.0.0", true).Write(&output))
+
+		assert.Contains(t, output.String(), "horusec-author")
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package junit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	vulnerabilityenum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	vulninfo "github.com/mosajjal/horusec/pkg/utils/vuln_info"
+)
+
+const reportName = "horusec"
+
+type JUnit struct {
+	analysis *analysis.Analysis
+}
+
+func NewJUnit(entity *analysis.Analysis) *JUnit {
+	return &JUnit{
+		analysis: entity,
+	}
+}
+
+// ConvertVulnerabilityToJUnit convert the analysis vulnerabilities to a JUnit report, where
+// each rule is a testsuite and each vulnerability of the rule a failing testcase. Vulnerabilities
+// that are not of the vulnerability type, like false positives, are reported as skipped.
+func (j *JUnit) ConvertVulnerabilityToJUnit() (report Report) {
+	report.Name = reportName
+	report.TestSuites = []TestSuite{}
+
+	suites := make(map[string]*TestSuite)
+	for index := range j.analysis.AnalysisVulnerabilities {
+		vuln := j.analysis.AnalysisVulnerabilities[index].Vulnerability
+
+		suite := j.getOrCreateTestSuite(suites, &vuln)
+		j.addTestCase(suite, &vuln)
+	}
+
+	for _, suite := range j.sortedTestSuites(suites) {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.TestSuites = append(report.TestSuites, *suite)
+	}
+
+	return report
+}
+
+func (j *JUnit) getOrCreateTestSuite(suites map[string]*TestSuite, vuln *vulnerability.Vulnerability) *TestSuite {
+	name := vulninfo.RuleName(vuln)
+	if suite, ok := suites[name]; ok {
+		return suite
+	}
+
+	suites[name] = &TestSuite{Name: name, TestCases: []TestCase{}}
+
+	return suites[name]
+}
+
+func (j *JUnit) addTestCase(suite *TestSuite, vuln *vulnerability.Vulnerability) {
+	testCase := TestCase{
+		Name:      j.getLocation(vuln),
+		ClassName: suite.Name,
+		File:      vuln.File,
+		Line:      vuln.Line,
+	}
+
+	if vuln.Type == vulnerabilityenum.Vulnerability {
+		testCase.Failure = j.newFailure(vuln)
+		suite.Failures++
+	} else {
+		testCase.Skipped = &Skipped{Message: vuln.Type.ToString()}
+		suite.Skipped++
+	}
+
+	suite.Tests++
+	suite.TestCases = append(suite.TestCases, testCase)
+}
+
+func (j *JUnit) newFailure(vuln *vulnerability.Vulnerability) *Failure {
+	return &Failure{
+		Message: fmt.Sprintf("[%s] %s", vuln.Severity.ToString(), j.getLocation(vuln)),
+		Type:    vuln.Severity.ToString(),
+		Text:    j.getFailureText(vuln),
+	}
+}
+
+func (j *JUnit) getFailureText(vuln *vulnerability.Vulnerability) string {
+	lines := []string{
+		fmt.Sprintf("Severity: %s", vuln.Severity.ToString()),
+		fmt.Sprintf("Confidence: %s", vuln.Confidence.ToString()),
+		fmt.Sprintf("Tool: %s", vuln.SecurityTool.ToString()),
+		fmt.Sprintf("Language: %s", vuln.Language.ToString()),
+		fmt.Sprintf("File: %s", j.getLocation(vuln)),
+		fmt.Sprintf("Code: %s", vuln.Code),
+		fmt.Sprintf("Details: %s", vuln.Details),
+		fmt.Sprintf("ReferenceHash: %s", vuln.VulnHash),
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (j *JUnit) getLocation(vuln *vulnerability.Vulnerability) string {
+	if vuln.Line == "" {
+		return vuln.File
+	}
+
+	return fmt.Sprintf("%s:%s", vuln.File, vuln.Line)
+}
+
+func (j *JUnit) sortedTestSuites(suites map[string]*TestSuite) []*TestSuite {
+	sorted := make([]*TestSuite, 0, len(suites))
+	for _, suite := range suites {
+		sorted = append(sorted, suite)
+	}
+
+	sort.Slice(sorted, func(i, k int) bool {
+		return sorted[i].Name < sorted[k].Name
+	})
+
+	return sorted
+}
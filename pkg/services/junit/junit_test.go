@@ -0,0 +1,101 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package junit
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumHorusec "github.com/ZupIT/horusec-devkit/pkg/enums/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	vulnerabilityenum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertVulnerabilityToJUnit(t *testing.T) {
+	t.Run("should group vulnerabilities by rule as failing testcases", func(t *testing.T) {
+		entity := &analysis.Analysis{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			Status:    enumHorusec.Success,
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						RuleID:   "HS-GO-3",
+						File:     "main.go",
+						Line:     "10",
+						Details:  "weak hash",
+						Severity: severities.Medium,
+						Type:     vulnerabilityenum.Vulnerability,
+					},
+				},
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						RuleID:   "HS-GO-3",
+						File:     "other.go",
+						Line:     "2",
+						Severity: severities.Medium,
+						Type:     vulnerabilityenum.RiskAccepted,
+					},
+				},
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						File:         "go.sum",
+						Severity:     severities.High,
+						SecurityTool: tools.Nancy,
+						Type:         vulnerabilityenum.Vulnerability,
+					},
+				},
+			},
+		}
+
+		report := NewJUnit(entity).ConvertVulnerabilityToJUnit()
+
+		assert.Equal(t, 3, report.Tests)
+		assert.Equal(t, 2, report.Failures)
+		assert.Equal(t, 1, report.Skipped)
+		require.Len(t, report.TestSuites, 2)
+
+		suite := report.TestSuites[0]
+		assert.Equal(t, "HS-GO-3", suite.Name)
+		assert.Equal(t, 2, suite.Tests)
+		assert.Equal(t, 1, suite.Failures)
+		assert.Equal(t, 1, suite.Skipped)
+
+		testCase := suite.TestCases[0]
+		assert.Equal(t, "main.go:10", testCase.Name)
+		require.NotNil(t, testCase.Failure)
+		assert.Equal(t, severities.Medium.ToString(), testCase.Failure.Type)
+		assert.Contains(t, testCase.Failure.Text, "Details: weak hash")
+		assert.Equal(t, vulnerabilityenum.RiskAccepted.ToString(), suite.TestCases[1].Skipped.Message)
+
+		assert.Equal(t, tools.Nancy.ToString(), report.TestSuites[1].Name)
+		assert.Equal(t, "go.sum", report.TestSuites[1].TestCases[0].Name)
+	})
+
+	t.Run("should marshal an empty report without vulnerabilities", func(t *testing.T) {
+		report := NewJUnit(&analysis.Analysis{}).ConvertVulnerabilityToJUnit()
+
+		b, err := xml.Marshal(report)
+		require.NoError(t, err)
+		assert.Equal(t, `<testsuites name="horusec" tests="0" failures="0" skipped="0"></testsuites>`, string(b))
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+const (
+	helmReleaseName      = "release-name"
+	helmReleaseNamespace = "default"
+)
+
+// renderChart render the chart and its subcharts using their default values, with the same
+// template engine of helm template. The templates that was rendered are replaced by their
+// rendered manifests. Since there is no cluster, lookup return empty values and the
+// capabilities are the default ones of Helm. Charts that fail to render, e.g. because of a
+// required value without default, are analyzed as they are.
+func (r *Rendered) renderChart(chartPath string) {
+	chart, err := loader.Load(chartPath)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, chartPath, err)
+		return
+	}
+
+	values := chartutil.Values{}
+	if err = chartutil.ProcessDependenciesWithMerge(chart, values); err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, chartPath, err)
+		return
+	}
+
+	options := chartutil.ReleaseOptions{
+		Name: helmReleaseName, Namespace: helmReleaseNamespace, Revision: 1, IsInstall: true,
+	}
+
+	renderValues, err := chartutil.ToRenderValues(chart, values, options, nil)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, chartPath, err)
+		return
+	}
+
+	manifests, err := engine.Render(chart, renderValues)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, chartPath, err)
+		return
+	}
+
+	r.writeHelmManifests(chartPath, manifests)
+}
+
+// writeHelmManifests write the manifests rendered from each template of the chart. The
+// manifests are indexed by the template name, which starts with the chart name instead
+// of its folder, e.g. "sample/templates/deployment.yaml".
+func (r *Rendered) writeHelmManifests(chartPath string, manifests map[string]string) {
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !isHelmManifest(name) {
+			continue
+		}
+
+		_, templateName, _ := strings.Cut(name, "/")
+		r.writeHelmManifest(filepath.Join(chartPath, filepath.FromSlash(templateName)), manifests[name])
+	}
+}
+
+func (r *Rendered) writeHelmManifest(templatePath, manifest string) {
+	content, err := os.ReadFile(templatePath)
+	if err != nil {
+		// Templates of packaged subcharts are not on the project files.
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, templatePath, err)
+		return
+	}
+
+	r.templates[templatePath] = true
+
+	if strings.TrimSpace(manifest) == "" {
+		return
+	}
+
+	if err := r.writeManifest(manifest, helmLineLocations(templatePath, string(content), manifest)); err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, templatePath, err)
+	}
+}
+
+// isHelmManifest return false to the partials and notes rendered by Helm, which are not manifests.
+func isHelmManifest(name string) bool {
+	ext := filepath.Ext(name)
+
+	return !strings.HasPrefix(filepath.Base(name), "_") && (ext == ".yaml" || ext == ".yml")
+}
+
+// helmLineLocations map each rendered line to the template line that most likely
+// produced it, searching a template line with the same YAML key or, for lines
+// without a key, with the same content. Lines that can not be found, such as the
+// ones generated by toYaml or include, use the location of the previous line.
+func helmLineLocations(templatePath, template, rendered string) []location {
+	templateLines := strings.Split(template, "\n")
+	renderedLines := strings.Split(rendered, "\n")
+	locations := make([]location, len(renderedLines))
+
+	current := 0
+	for index, line := range renderedLines {
+		if found := findTemplateLine(templateLines, current, line); found >= 0 {
+			current = found
+		}
+
+		locations[index] = location{file: templatePath, line: current + 1}
+	}
+
+	return locations
+}
+
+// findTemplateLine search the rendered line on the template after the current
+// line and, since templates can have loops, from the beginning when not found.
+func findTemplateLine(templateLines []string, current int, rendered string) int {
+	if strings.TrimSpace(rendered) == "" {
+		return -1
+	}
+
+	for index := current + 1; index < len(templateLines); index++ {
+		if isSameYAMLLine(templateLines[index], rendered) {
+			return index
+		}
+	}
+
+	for index := 0; index <= current && index < len(templateLines); index++ {
+		if isSameYAMLLine(templateLines[index], rendered) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func isSameYAMLLine(templateLine, renderedLine string) bool {
+	if key := yamlKey(renderedLine); key != "" {
+		return yamlKey(templateLine) == key
+	}
+
+	return strings.TrimSpace(templateLine) == strings.TrimSpace(renderedLine)
+}
+
+// yamlKey return the key of a YAML line, e.g. "privileged" to "- privileged: true",
+// or an empty string if the line does not contain a key.
+func yamlKey(line string) string {
+	line = strings.TrimPrefix(strings.TrimSpace(line), "- ")
+
+	index := strings.Index(line, ":")
+	if index <= 0 {
+		return ""
+	}
+
+	key := strings.Trim(line[:index], `"'`)
+	if strings.ContainsAny(key, " {}\t") {
+		return ""
+	}
+
+	return key
+}
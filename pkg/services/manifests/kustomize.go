@@ -0,0 +1,496 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/resource"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+// renderKustomization build the kustomization on path with kustomize and write each resource
+// loaded from the project files, with its lines mapped back to the patch that set them or to
+// the file the resource was loaded from.
+func (r *Rendered) renderKustomization(path string) {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, path, err)
+		return
+	}
+
+	resources, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).
+		Run(kustomizeFS{FileSystem: filesys.MakeFsOnDisk(), root: root}, root)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, path, err)
+		return
+	}
+
+	mapper := &kustomizeMapper{root: root, documents: make(map[string][]*yaml.Node)}
+	for _, res := range resources.Resources() {
+		content, locations, err := mapper.render(res)
+		if err != nil || locations == nil {
+			continue
+		}
+
+		if err := r.writeManifest(content, locations); err != nil {
+			logger.LogDebugWithLevel(messages.MsgDebugManifestRenderFailed, path, err)
+		}
+	}
+}
+
+// kustomizeFS is the file system used to build kustomizations. The kustomizations read from it
+// don't have the resources that are not on the project files, which kustomize would fetch from
+// the network, and the helmCharts, which kustomize would render with the helm binary. The root
+// kustomization also enables the annotations used to map the resources back to the project files.
+type kustomizeFS struct {
+	filesys.FileSystem
+	root string
+}
+
+func (f kustomizeFS) ReadFile(path string) ([]byte, error) {
+	content, err := f.FileSystem.ReadFile(path)
+	if err != nil || !isKustomizationFile(filepath.Base(path)) {
+		return content, err
+	}
+
+	config := make(map[string]interface{})
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, err
+	}
+
+	for _, field := range []string{"resources", "bases", "components"} {
+		if entries, ok := config[field].([]interface{}); ok {
+			config[field] = f.localEntries(filepath.Dir(path), entries)
+		}
+	}
+
+	if _, ok := config["helmCharts"]; ok {
+		logger.LogDebugWithLevel(messages.MsgDebugManifestHelmChartsIgnored, path)
+		delete(config, "helmCharts")
+	}
+
+	if filepath.Dir(path) == f.root {
+		config["buildMetadata"] = []string{types.OriginAnnotations, types.TransformerAnnotations}
+	}
+
+	return yaml.Marshal(config)
+}
+
+func (f kustomizeFS) localEntries(dir string, entries []interface{}) []interface{} {
+	local := make([]interface{}, 0, len(entries))
+
+	for _, entry := range entries {
+		if name, ok := entry.(string); ok && !f.Exists(filepath.Join(dir, name)) {
+			logger.LogDebugWithLevel(messages.MsgDebugManifestResourceIgnored, name)
+			continue
+		}
+
+		local = append(local, entry)
+	}
+
+	return local
+}
+
+// kustomizeSource is a YAML document of the project files that can set the lines of a resource.
+type kustomizeSource struct {
+	file      string
+	node      *yaml.Node
+	jsonPatch bool
+}
+
+// kustomizePathSegment is a key of a mapping or an item of a sequence, that is identified by
+// its name field, like containers and volumes, or by its index when it has no name.
+type kustomizePathSegment struct {
+	key   string
+	name  string
+	index int
+}
+
+// kustomizeMapper map the lines of the resources built by kustomize to the project files, using
+// the origin and transformer annotations of each resource. A line is mapped to the last patch
+// that set it or, when no patch set it, to the file the resource was loaded from. Lines that are
+// not found, like the ones of inline patches, use the location of the previous line.
+type kustomizeMapper struct {
+	root      string
+	documents map[string][]*yaml.Node
+}
+
+func (m *kustomizeMapper) render(res *resource.Resource) (string, []location, error) {
+	origin, err := res.GetOrigin()
+	if err != nil || origin == nil || origin.Path == "" || origin.Repo != "" {
+		// Resources created by generators are not on the project files.
+		return "", nil, err
+	}
+
+	transformations, err := res.GetTransformations()
+	if err != nil {
+		return "", nil, err
+	}
+
+	sources := m.sources(res, origin, transformations)
+	if len(sources) == 0 {
+		return "", nil, nil
+	}
+
+	// The annotations are removed, since they are not on the project files.
+	if err := res.SetOrigin(nil); err != nil {
+		return "", nil, err
+	}
+
+	if err := res.ClearTransformations(); err != nil {
+		return "", nil, err
+	}
+
+	content, err := res.AsYAML()
+	if err != nil {
+		return "", nil, err
+	}
+
+	rendered := &yaml.Node{}
+	if err := yaml.Unmarshal(content, rendered); err != nil {
+		return "", nil, err
+	}
+
+	locations := make([]location, strings.Count(string(content), "\n")+1)
+	m.mapNode(documentContent(rendered), nil, sources, locations)
+	fillLocations(locations, sources[len(sources)-1])
+
+	return string(content), locations, nil
+}
+
+// sources return the documents that can set the lines of the resource, from the last patch
+// applied to it to the document it was loaded from, or nil if that document is not found.
+func (m *kustomizeMapper) sources(res *resource.Resource, origin *resource.Origin,
+	transformations resource.Transformations) (sources []kustomizeSource) {
+	file := filepath.Join(m.root, origin.Path)
+
+	document := originDocument(res, m.parseFile(file))
+	if document == nil {
+		return nil
+	}
+
+	// The name of the resource on the patches is the one before namePrefix and nameSuffix.
+	id := res.CurId()
+	id.Name = resourceName(document)
+	seen := make(map[string]bool)
+
+	for index := len(transformations) - 1; index >= 0; index-- {
+		configuredIn := transformations[index].ConfiguredIn
+		if !strings.HasPrefix(transformations[index].ConfiguredBy.Kind, "Patch") || seen[configuredIn] {
+			continue
+		}
+
+		seen[configuredIn] = true
+		sources = append(sources, m.patchSources(id, filepath.Join(m.root, configuredIn))...)
+	}
+
+	return append(sources, kustomizeSource{file: file, node: document})
+}
+
+// originDocument return the document of the file the resource was loaded from, which has the
+// same kind and the name of the resource without the prefix and suffix added by kustomize.
+func originDocument(res *resource.Resource, documents []*yaml.Node) (found *yaml.Node) {
+	for _, document := range documents {
+		name := resourceName(document)
+		if scalarValue(document, "kind") == res.GetKind() && name != "" && strings.Contains(res.GetName(), name) &&
+			(found == nil || len(name) > len(resourceName(found))) {
+			found = document
+		}
+	}
+
+	return found
+}
+
+// patchSources return the documents of the patch files of the kustomization that target the
+// resource, from the last to the first patch applied.
+func (m *kustomizeMapper) patchSources(id resid.ResId, kustomizationPath string) (sources []kustomizeSource) {
+	for _, patch := range kustomizationPatches(kustomizationPath) {
+		file := filepath.Join(filepath.Dir(kustomizationPath), patch.Path)
+		for _, document := range m.parseFile(file) {
+			if isPatchOf(id, document, patch.Target) {
+				sources = append(sources, kustomizeSource{
+					file: file, node: document, jsonPatch: document.Kind == yaml.SequenceNode,
+				})
+			}
+		}
+	}
+
+	return sources
+}
+
+// kustomizationPatches return the patch files of the kustomization, from the last to the first
+// one applied. Inline patches are not returned, since they are not on patch files.
+func kustomizationPatches(path string) (patches []types.Patch) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	config := &types.Kustomization{}
+	if err := config.Unmarshal(content); err != nil {
+		return nil
+	}
+
+	all := append(config.Patches, config.PatchesJson6902...)
+	for _, patch := range config.PatchesStrategicMerge {
+		all = append(all, types.Patch{Path: string(patch)})
+	}
+
+	for index := len(all) - 1; index >= 0; index-- {
+		if all[index].Path != "" && !strings.Contains(all[index].Path, "\n") {
+			patches = append(patches, all[index])
+		}
+	}
+
+	return patches
+}
+
+// isPatchOf return true if the patch document is applied to the resource, selecting it by the
+// target of the patch or, for strategic merge patches without target, by its kind and name.
+func isPatchOf(id resid.ResId, document *yaml.Node, target *types.Selector) bool {
+	if target != nil {
+		return id.IsSelectedBy(target.ResId)
+	}
+
+	return document.Kind == yaml.MappingNode &&
+		scalarValue(document, "kind") == id.Kind && resourceName(document) == id.Name
+}
+
+func (m *kustomizeMapper) parseFile(path string) []*yaml.Node {
+	if documents, ok := m.documents[path]; ok {
+		return documents
+	}
+
+	var documents []*yaml.Node
+
+	if content, err := os.ReadFile(path); err == nil {
+		decoder := yaml.NewDecoder(strings.NewReader(string(content)))
+		for {
+			document := &yaml.Node{}
+			if err := decoder.Decode(document); err != nil {
+				break
+			}
+
+			documents = append(documents, documentContent(document))
+		}
+	}
+
+	m.documents[path] = documents
+
+	return documents
+}
+
+// mapNode set the location of each key and scalar item of node, which is on path of the resource.
+func (m *kustomizeMapper) mapNode(node *yaml.Node, path []kustomizePathSegment,
+	sources []kustomizeSource, locations []location) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for index := 0; index+1 < len(node.Content); index += 2 {
+			keyPath := appendSegment(path, kustomizePathSegment{key: node.Content[index].Value})
+			setLocation(locations, node.Content[index].Line, findLocation(sources, keyPath))
+			m.mapNode(node.Content[index+1], keyPath, sources, locations)
+		}
+	case yaml.SequenceNode:
+		for index, item := range node.Content {
+			itemPath := appendSegment(path, kustomizePathSegment{name: scalarValue(item, "name"), index: index})
+			if item.Kind == yaml.ScalarNode {
+				setLocation(locations, item.Line, findLocation(sources, itemPath))
+			}
+
+			m.mapNode(item, itemPath, sources, locations)
+		}
+	}
+}
+
+func appendSegment(path []kustomizePathSegment, segment kustomizePathSegment) []kustomizePathSegment {
+	return append(append(make([]kustomizePathSegment, 0, len(path)+1), path...), segment)
+}
+
+func setLocation(locations []location, line int, loc *location) {
+	if loc != nil && line > 0 && line <= len(locations) {
+		locations[line-1] = *loc
+	}
+}
+
+// fillLocations set the lines that were not found to the location of the previous line or,
+// to the first lines, to the first line of the document the resource was loaded from.
+func fillLocations(locations []location, origin kustomizeSource) {
+	previous := location{file: origin.file, line: origin.node.Line}
+
+	for index := range locations {
+		if locations[index].file == "" {
+			locations[index] = previous
+		}
+
+		previous = locations[index]
+	}
+}
+
+func findLocation(sources []kustomizeSource, path []kustomizePathSegment) *location {
+	for _, source := range sources {
+		node := findNode(source, path)
+		if node != nil {
+			return &location{file: source.file, line: node.Line}
+		}
+	}
+
+	return nil
+}
+
+func findNode(source kustomizeSource, path []kustomizePathSegment) *yaml.Node {
+	if !source.jsonPatch {
+		return nodeAtSegments(source.node, path)
+	}
+
+	for _, operation := range source.node.Content {
+		op := scalarValue(operation, "op")
+		if op != "add" && op != "replace" {
+			continue
+		}
+
+		rest, ok := trimJSONPointer(path, scalarValue(operation, "path"))
+		if value := mappingValue(operation, "value"); ok && value != nil {
+			if len(rest) == 0 {
+				return mappingKey(operation, "value")
+			}
+
+			return nodeAtSegments(value, rest)
+		}
+	}
+
+	return nil
+}
+
+// trimJSONPointer return the segments of path after the JSON pointer, or false if the pointer
+// is not a prefix of path. Items of sequences are compared by their indexes.
+func trimJSONPointer(path []kustomizePathSegment, pointer string) ([]kustomizePathSegment, bool) {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	if pointer == "" || len(tokens) > len(path) {
+		return nil, false
+	}
+
+	for index, token := range tokens {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if path[index].key != token && (path[index].key != "" || strconv.Itoa(path[index].index) != token) {
+			return nil, false
+		}
+	}
+
+	return path[len(tokens):], true
+}
+
+// nodeAtSegments return the key or the item on path of node, or nil if it doesn't exist.
+func nodeAtSegments(node *yaml.Node, path []kustomizePathSegment) (found *yaml.Node) {
+	for _, segment := range path {
+		if node == nil {
+			return nil
+		}
+
+		if segment.key != "" {
+			found, node = mappingKey(node, segment.key), mappingValue(node, segment.key)
+			continue
+		}
+
+		found = sequenceItem(node, segment)
+		node = found
+	}
+
+	return found
+}
+
+func sequenceItem(node *yaml.Node, segment kustomizePathSegment) *yaml.Node {
+	if node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	if segment.name != "" {
+		for _, item := range node.Content {
+			if scalarValue(item, "name") == segment.name {
+				return item
+			}
+		}
+
+		return nil
+	}
+
+	if segment.index < len(node.Content) {
+		return node.Content[segment.index]
+	}
+
+	return nil
+}
+
+func documentContent(document *yaml.Node) *yaml.Node {
+	if document.Kind == yaml.DocumentNode && len(document.Content) > 0 {
+		return document.Content[0]
+	}
+
+	return document
+}
+
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			return node.Content[index]
+		}
+	}
+
+	return nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			return node.Content[index+1]
+		}
+	}
+
+	return nil
+}
+
+func scalarValue(node *yaml.Node, key string) string {
+	if value := mappingValue(node, key); value != nil && value.Kind == yaml.ScalarNode {
+		return value.Value
+	}
+
+	return ""
+}
+
+func resourceName(resource *yaml.Node) string {
+	if metadata := mappingValue(resource, "metadata"); metadata != nil {
+		return scalarValue(metadata, "name")
+	}
+
+	return ""
+}
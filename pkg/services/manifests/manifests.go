@@ -0,0 +1,223 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+)
+
+const (
+	chartFile        = "Chart.yaml"
+	renderedFileName = "%d.yaml"
+)
+
+// location is a line of a file from the analyzed project.
+type location struct {
+	file string
+	line int
+}
+
+// Rendered contains the manifests rendered from Helm charts and Kustomize
+// overlays of a project, so Kubernetes rules can be applied to the final
+// manifests instead of the raw templates. Each rendered manifest is written
+// on a temporary directory, which should be removed with Remove after use.
+type Rendered struct {
+	path      string
+	count     int
+	locations map[string][]location
+	templates map[string]bool
+	lines     map[string][]string
+}
+
+// Render render all Helm charts, using their default values, and all Kustomize
+// overlays found on projectPath. Templates and overlays that can not be rendered
+// are logged and ignored, so their files are still analyzed as they are.
+//
+// Rendering has the following limits:
+//
+//   - Helm charts are rendered in-process by the Helm template engine, with the default
+//     values and capabilities of Helm. Lookup return empty values, since there is no cluster,
+//     and subcharts are only mapped back to their templates when they are not packaged.
+//   - Kustomize overlays are built in-process by kustomize. Remote resources and helmCharts
+//     are ignored, since they would need the network or the helm binary.
+//   - Rendered lines are mapped back to Helm templates by their YAML keys, so values
+//     generated by include or toYaml are reported on the line that renders them. Lines
+//     set by inline Kustomize patches are reported on the line of the resource they patch.
+func Render(projectPath string) (*Rendered, error) {
+	path, err := os.MkdirTemp("", "horusec-manifests-")
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &Rendered{
+		path:      path,
+		locations: make(map[string][]location),
+		templates: make(map[string]bool),
+		lines:     make(map[string][]string),
+	}
+
+	charts, kustomizations, err := findChartsAndKustomizations(projectPath)
+	if err != nil {
+		rendered.Remove()
+		return nil, err
+	}
+
+	for _, chart := range charts {
+		rendered.renderChart(chart)
+	}
+
+	for _, kustomization := range kustomizations {
+		rendered.renderKustomization(kustomization)
+	}
+
+	return rendered, nil
+}
+
+// Path return the directory that contains the rendered manifests.
+func (r *Rendered) Path() string {
+	return r.path
+}
+
+// IsEmpty return true if no manifest was rendered.
+func (r *Rendered) IsEmpty() bool {
+	return r.count == 0
+}
+
+// Remove remove the directory that contains the rendered manifests.
+func (r *Rendered) Remove() {
+	_ = os.RemoveAll(r.path)
+}
+
+// MergeFindings merge the findings from the project files with the findings from
+// rendered manifests. Findings on Helm templates that were rendered are replaced
+// by the findings of their rendered manifests, which are mapped back to the line
+// of the file that produced them. Duplicated findings are reported only once.
+func (r *Rendered) MergeFindings(projectFindings, renderedFindings []engine.Finding) []engine.Finding {
+	findings := make([]engine.Finding, 0, len(projectFindings)+len(renderedFindings))
+	alreadyFound := make(map[string]bool)
+
+	for index := range projectFindings {
+		if r.templates[projectFindings[index].SourceLocation.Filename] {
+			continue
+		}
+
+		alreadyFound[r.findingKey(&projectFindings[index])] = true
+		findings = append(findings, projectFindings[index])
+	}
+
+	for index := range renderedFindings {
+		finding, ok := r.mapFinding(renderedFindings[index])
+		if !ok || alreadyFound[r.findingKey(&finding)] {
+			continue
+		}
+
+		alreadyFound[r.findingKey(&finding)] = true
+		findings = append(findings, finding)
+	}
+
+	return findings
+}
+
+func (r *Rendered) findingKey(finding *engine.Finding) string {
+	return fmt.Sprintf("%s:%s:%d", finding.ID, finding.SourceLocation.Filename, finding.SourceLocation.Line)
+}
+
+func (r *Rendered) mapFinding(finding engine.Finding) (engine.Finding, bool) {
+	locations, exists := r.locations[finding.SourceLocation.Filename]
+	if !exists || finding.SourceLocation.Line < 1 || finding.SourceLocation.Line > len(locations) {
+		return finding, false
+	}
+
+	loc := locations[finding.SourceLocation.Line-1]
+	code := r.lineOfFile(loc.file, loc.line)
+
+	finding.SourceLocation.Filename = loc.file
+	finding.SourceLocation.Line = loc.line
+	finding.SourceLocation.Column = len(code) - len(strings.TrimLeft(code, " \t"))
+	finding.CodeSample = strings.TrimSpace(code)
+
+	return finding, true
+}
+
+func (r *Rendered) lineOfFile(path string, line int) string {
+	lines, exists := r.lines[path]
+	if !exists {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return ""
+		}
+
+		lines = strings.Split(string(content), "\n")
+		r.lines[path] = lines
+	}
+
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+
+	return strings.TrimRight(lines[line-1], "\r")
+}
+
+// writeManifest write a rendered manifest to the temporary directory, with the
+// location on the project files of each one of its lines.
+func (r *Rendered) writeManifest(content string, locations []location) error {
+	r.count++
+	path := filepath.Join(r.path, fmt.Sprintf(renderedFileName, r.count))
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		return err
+	}
+
+	r.locations[path] = locations
+
+	return nil
+}
+
+func findChartsAndKustomizations(projectPath string) (charts, kustomizations []string, err error) {
+	err = filepath.WalkDir(projectPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		switch {
+		case entry.Name() == chartFile:
+			charts = append(charts, filepath.Dir(path))
+		case isKustomizationFile(entry.Name()):
+			kustomizations = append(kustomizations, filepath.Dir(path))
+		}
+
+		return nil
+	})
+
+	return charts, kustomizations, err
+}
+
+func isKustomizationFile(name string) bool {
+	return name == "kustomization.yaml" || name == "kustomization.yml" || name == "Kustomization"
+}
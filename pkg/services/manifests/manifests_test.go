@@ -0,0 +1,263 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosajjal/horusec/pkg/services/engines/kubernetes"
+)
+
+const (
+	sampleChart = `
+apiVersion: v2
+name: sample
+version: 0.1.0
+`
+	sampleChartValues = `
+hostNetworkEnabled: true
+image: nginx
+`
+	sampleChartDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}-{{ .Chart.Name }}
+spec:
+  template:
+    spec:
+      hostNetwork: {{ .Values.hostNetworkEnabled }}
+      containers:
+        - name: {{ .Chart.Name }}
+          image: {{ .Values.image | quote }}
+`
+	sampleKustomizationBase = `
+resources:
+  - deployment.yaml
+`
+	sampleKustomizationDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      hostPID: false
+      containers:
+        - name: app
+          image: nginx
+`
+	sampleKustomizationOverlay = `
+namePrefix: prod-
+resources:
+  - ../base
+patchesStrategicMerge:
+  - patch.yaml
+`
+	sampleKustomizationJSONPatch = `- op: add
+  path: /spec/template/spec/containers/0/securityContext
+  value:
+    runAsUser: 1000
+    privileged: true
+`
+	sampleKustomizationPatch = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      hostPID: true
+`
+)
+
+func TestRender(t *testing.T) {
+	t.Run("Should render Helm chart and map findings to the template line", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"chart/Chart.yaml":                sampleChart,
+			"chart/values.yaml":               sampleChartValues,
+			"chart/templates/deployment.yaml": sampleChartDeployment,
+		})
+
+		findings := runKubernetesRules(t, projectPath)
+
+		require.Len(t, findings, 1)
+		assert.Equal(t, "HS-KUBERNETES-9", findings[0].ID)
+		assert.Equal(t, filepath.Join(projectPath, "chart", "templates", "deployment.yaml"),
+			findings[0].SourceLocation.Filename)
+		assert.Equal(t, 8, findings[0].SourceLocation.Line)
+		assert.Equal(t, "hostNetwork: {{ .Values.hostNetworkEnabled }}", findings[0].CodeSample)
+	})
+
+	t.Run("Should render Helm subcharts and map findings to their templates", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"chart/Chart.yaml":                          sampleChart + "dependencies:\n  - name: db\n    version: 0.1.0\n",
+			"chart/values.yaml":                         "db:\n  hostNetworkEnabled: true\n",
+			"chart/templates/deployment.yaml":           sampleChartDeployment,
+			"chart/charts/db/Chart.yaml":                "apiVersion: v2\nname: db\nversion: 0.1.0\n",
+			"chart/charts/db/templates/deployment.yaml": sampleChartDeployment,
+		})
+
+		findings := runKubernetesRules(t, projectPath)
+
+		require.Len(t, findings, 1)
+		assert.Equal(t, "HS-KUBERNETES-9", findings[0].ID)
+		assert.Equal(t, filepath.Join(projectPath, "chart", "charts", "db", "templates", "deployment.yaml"),
+			findings[0].SourceLocation.Filename)
+		assert.Equal(t, 8, findings[0].SourceLocation.Line)
+	})
+
+	t.Run("Should analyze Helm chart as is when it fails to render", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"chart/Chart.yaml":                sampleChart,
+			"chart/templates/deployment.yaml": sampleChartDeployment + "{{ required \"image is required\" .Values.missing }}\n",
+		})
+
+		rendered, err := Render(projectPath)
+		require.NoError(t, err)
+		defer rendered.Remove()
+
+		assert.True(t, rendered.IsEmpty())
+		assert.Empty(t, rendered.templates)
+	})
+
+	t.Run("Should render Kustomize overlay without fetching remote resources", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"base/kustomization.yaml": sampleKustomizationBase,
+			"base/deployment.yaml":    sampleKustomizationDeployment,
+			"overlay/kustomization.yaml": "resources:\n  - ../base\n" +
+				"  - https://github.com/example/manifests//base?ref=v1.0.0\n",
+			"overlay/patch.yaml": sampleKustomizationPatch,
+		})
+
+		rendered, err := Render(projectPath)
+		require.NoError(t, err)
+		defer rendered.Remove()
+
+		assert.Equal(t, 2, rendered.count, "Expected the base and the overlay to be rendered")
+	})
+
+	t.Run("Should not render Kustomize overlay that fails to build", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"base/kustomization.yaml":    sampleKustomizationBase,
+			"base/deployment.yaml":       sampleKustomizationDeployment,
+			"overlay/kustomization.yaml": sampleKustomizationOverlay,
+		})
+
+		rendered, err := Render(projectPath)
+		require.NoError(t, err)
+		defer rendered.Remove()
+
+		assert.Equal(t, 1, rendered.count, "Expected only the base to be rendered")
+	})
+
+	t.Run("Should render Kustomize overlay and map findings to the patch line", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"base/kustomization.yaml":    sampleKustomizationBase,
+			"base/deployment.yaml":       sampleKustomizationDeployment,
+			"overlay/kustomization.yaml": sampleKustomizationOverlay,
+			"overlay/patch.yaml":         sampleKustomizationPatch,
+		})
+
+		findings := runKubernetesRules(t, projectPath)
+
+		var patchFindings []engine.Finding
+		for _, finding := range findings {
+			if finding.ID == "HS-KUBERNETES-8" {
+				patchFindings = append(patchFindings, finding)
+			}
+		}
+
+		require.Len(t, patchFindings, 1)
+		assert.Equal(t, filepath.Join(projectPath, "overlay", "patch.yaml"), patchFindings[0].SourceLocation.Filename)
+		assert.Equal(t, 8, patchFindings[0].SourceLocation.Line)
+		assert.Equal(t, "hostPID: true", patchFindings[0].CodeSample)
+	})
+
+	t.Run("Should render Kustomize overlay and map findings to the JSON patch line", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{
+			"base/kustomization.yaml": sampleKustomizationBase,
+			"base/deployment.yaml":    sampleKustomizationDeployment,
+			"overlay/kustomization.yaml": "resources:\n  - ../base\npatches:\n  - path: patch.yaml\n" +
+				"    target:\n      kind: Deployment\n      name: app\n",
+			"overlay/patch.yaml": sampleKustomizationJSONPatch,
+		})
+
+		findings := runKubernetesRules(t, projectPath)
+
+		var patchFindings []engine.Finding
+		for _, finding := range findings {
+			if finding.ID == "HS-KUBERNETES-5" {
+				patchFindings = append(patchFindings, finding)
+			}
+		}
+
+		require.Len(t, patchFindings, 1)
+		assert.Equal(t, filepath.Join(projectPath, "overlay", "patch.yaml"), patchFindings[0].SourceLocation.Filename)
+		assert.Equal(t, 5, patchFindings[0].SourceLocation.Line)
+	})
+
+	t.Run("Should return empty rendered manifests when project has no chart or kustomization", func(t *testing.T) {
+		projectPath := t.TempDir()
+		writeFiles(t, projectPath, map[string]string{"deployment.yaml": sampleKustomizationDeployment})
+
+		rendered, err := Render(projectPath)
+		require.NoError(t, err)
+		defer rendered.Remove()
+
+		assert.True(t, rendered.IsEmpty())
+	})
+}
+
+func runKubernetesRules(t *testing.T, projectPath string) []engine.Finding {
+	rendered, err := Render(projectPath)
+	require.NoError(t, err)
+	defer rendered.Remove()
+
+	require.False(t, rendered.IsEmpty())
+
+	e := engine.NewEngine(0, ".yaml", ".yml")
+
+	projectFindings, err := e.Run(context.Background(), projectPath, kubernetes.Rules()...)
+	require.NoError(t, err)
+
+	renderedFindings, err := e.Run(context.Background(), rendered.Path(), kubernetes.Rules()...)
+	require.NoError(t, err)
+
+	return rendered.MergeFindings(projectFindings, renderedFindings)
+}
+
+func writeFiles(t *testing.T, basePath string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(basePath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+}
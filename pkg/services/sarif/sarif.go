@@ -23,19 +23,28 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 
 	"github.com/mosajjal/horusec/cmd/app/version"
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
 )
 
+// FingerprintKey is the key of the vulnerability fingerprint on the partial fingerprints of
+// a result, the version suffix should be changed if the fingerprint generation changes.
+const FingerprintKey = "horusecFingerprint/v1"
+
 type Sarif struct {
-	analysiss *analysis.Analysis
+	analysiss     *analysis.Analysis
+	fingerprinter *vulnhash.Fingerprinter
 
 	resultsByTool          map[string][]Result
 	rulesByToolAndID       map[string]map[string]Rule
 	artifactsByToolAndName map[string]map[string]Artifact
 }
 
-func NewSarif(analysiss *analysis.Analysis) *Sarif {
+// NewSarif create a new SARIF converter to analysis of the project on projectPath,
+// which is used to generate the fingerprints of the vulnerabilities.
+func NewSarif(analysiss *analysis.Analysis, projectPath string) *Sarif {
 	return &Sarif{
 		analysiss:              analysiss,
+		fingerprinter:          vulnhash.NewFingerprinter(projectPath),
 		resultsByTool:          make(map[string][]Result),
 		rulesByToolAndID:       make(map[string]map[string]Rule),
 		artifactsByToolAndName: make(map[string]map[string]Artifact),
@@ -156,6 +165,9 @@ func (s *Sarif) newResult(vulnerabilityy *vulnerability.Vulnerability) Result {
 		Level:     ResultLevel(s.convertHorusecSeverityToSarif(vulnerabilityy.Severity)),
 		Locations: []Location{s.createLocation(vulnerabilityy)},
 		RuleID:    vulnerabilityy.RuleID,
+		PartialFingerprints: map[string]string{
+			FingerprintKey: s.fingerprinter.Fingerprint(vulnerabilityy),
+		},
 	}
 }
 
@@ -26,6 +26,8 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	vulnhash "github.com/mosajjal/horusec/pkg/utils/vuln_hash"
 )
 
 func TestConvertVulnerabilityDataToSarif(t *testing.T) {
@@ -50,7 +52,7 @@ func TestConvertVulnerabilityDataToSarif(t *testing.T) {
 			},
 		}
 
-		service := NewSarif(entity)
+		service := NewSarif(entity, t.TempDir())
 
 		result := service.ConvertVulnerabilityToSarif()
 		assert.NotEmpty(t, result.Runs)
@@ -77,7 +79,7 @@ func TestConvertVulnerabilityDataToSarif(t *testing.T) {
 			},
 		}
 
-		service := NewSarif(analysis)
+		service := NewSarif(analysis, t.TempDir())
 
 		result := service.ConvertVulnerabilityToSarif()
 		assert.NotNil(t, result.Runs)
@@ -92,6 +94,9 @@ func TestConvertVulnerabilityDataToSarif(t *testing.T) {
 		assert.EqualValues(t, result.Runs[0].Results[0].Locations[0].PhysicalLocation.Region.StartColumn, 1)
 		assert.EqualValues(t, result.Runs[0].Results[0].Locations[0].PhysicalLocation.Region.StartLine, 1)
 
+		assert.Equal(t, vulnhash.Fingerprint(&analysis.AnalysisVulnerabilities[0].Vulnerability, nil),
+			result.Runs[0].Results[0].PartialFingerprints[FingerprintKey])
+
 		assert.NotNil(t, result.Runs[0].Tool)
 		assert.EqualValues(t, result.Runs[0].Tool.Driver.Name, "Bandit")
 	})
@@ -44,10 +44,11 @@ type Report struct {
 }
 
 type Result struct {
-	Message   TextDisplayComponent `json:"message"`
-	Level     ResultLevel          `json:"level"`
-	Locations []Location           `json:"locations"`
-	RuleID    string               `json:"ruleId"`
+	Message             TextDisplayComponent `json:"message"`
+	Level               ResultLevel          `json:"level"`
+	Locations           []Location           `json:"locations"`
+	RuleID              string               `json:"ruleId"`
+	PartialFingerprints map[string]string    `json:"partialFingerprints,omitempty"`
 }
 
 type ResultLevel string
@@ -0,0 +1,233 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	"github.com/mosajjal/horusec/pkg/utils/file"
+)
+
+// DetailsPrefix is the prefix of the line added on details of a suppressed vulnerability,
+// that shows the reason of the suppression on the outputs.
+const DetailsPrefix = "Suppressed by horusec:ignore comment"
+
+// commentRegex match the supported suppression comments, e.g:
+//
+//	// horusec:ignore HS-JAVA-123 reason="not reachable"
+//	# horusec:ignore
+//	-- horusec:ignore HS-SQL-1, HS-SQL-2
+//	<!-- horusec:ignore HS-HTML-1 -->
+//
+// The comment marker should start the line or be preceded by a whitespace, so
+// markers that are part of the code, like an URL or a #define, are not matched.
+var commentRegex = regexp.MustCompile(`(?:^|\s)(//|#|--|<!--|/\*)\s*horusec:ignore\b(.*)$`)
+
+var reasonRegex = regexp.MustCompile(`reason\s*=\s*"([^"]*)"`)
+
+// Suppression is an inline comment that suppress the vulnerabilities of a line.
+// When RuleIDs is empty, all vulnerabilities of the line are suppressed.
+type Suppression struct {
+	RuleIDs []string
+	Reason  string
+
+	// standalone is true when the comment is the only content of its line,
+	// so it applies to the next line too.
+	standalone bool
+}
+
+// Parse return the suppression declared on the line or false if there is none.
+func Parse(line string) (*Suppression, bool) {
+	match := findComment(line)
+	if match == nil {
+		return nil, false
+	}
+
+	args := line[match[4]:match[5]]
+	args = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(args), "-->"))
+	args = strings.TrimSpace(strings.TrimSuffix(args, "*/"))
+
+	suppression := &Suppression{
+		standalone: strings.TrimSpace(line[:match[2]]) == "",
+	}
+
+	if reason := reasonRegex.FindStringSubmatch(args); reason != nil {
+		suppression.Reason = reason[1]
+		args = reasonRegex.ReplaceAllString(args, "")
+	}
+
+	for _, ruleID := range strings.FieldsFunc(args, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	}) {
+		suppression.RuleIDs = append(suppression.RuleIDs, ruleID)
+	}
+
+	return suppression, true
+}
+
+// findComment return the submatch indexes of the first suppression comment of the line
+// that is not inside a string literal or nil if there is none.
+func findComment(line string) []int {
+	for offset := 0; offset < len(line); {
+		match := commentRegex.FindStringSubmatchIndex(line[offset:])
+		if match == nil {
+			return nil
+		}
+
+		for index := range match {
+			match[index] += offset
+		}
+
+		if !isInsideStringLiteral(line[:match[2]]) {
+			return match
+		}
+
+		offset = match[3]
+	}
+
+	return nil
+}
+
+// isInsideStringLiteral return true if the code before some position of a line has a
+// string literal that was not closed, so the position is inside of it.
+func isInsideStringLiteral(code string) bool {
+	var quote rune
+
+	escaped := false
+	for _, char := range code {
+		switch {
+		case escaped:
+			escaped = false
+		case char == '\\' && quote != '`':
+			escaped = true
+		case quote == 0 && (char == '"' || char == '\'' || char == '`'):
+			quote = char
+		case char == quote:
+			quote = 0
+		}
+	}
+
+	return quote != 0
+}
+
+// Matches return true if the suppression applies to the rule.
+func (s *Suppression) Matches(ruleID string) bool {
+	if len(s.RuleIDs) == 0 {
+		return true
+	}
+
+	for _, id := range s.RuleIDs {
+		if strings.EqualFold(id, strings.TrimSpace(ruleID)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Details return the text added on details of a vulnerability suppressed by s.
+func (s *Suppression) Details() string {
+	if s.Reason == "" {
+		return DetailsPrefix
+	}
+
+	return fmt.Sprintf("%s: %s", DetailsPrefix, s.Reason)
+}
+
+// Set is a set of vulnerabilities suppressed by an inline comment. Different rules that
+// found the same code have the same hash, so the vulnerabilities are keyed by hash and rule.
+type Set map[string]struct{}
+
+// Add add the vulnerability on the set.
+func (s Set) Add(vuln *vulnerability.Vulnerability) {
+	s[setKey(vuln)] = struct{}{}
+}
+
+// Contains return true if the vulnerability is on the set.
+func (s Set) Contains(vuln *vulnerability.Vulnerability) bool {
+	_, ok := s[setKey(vuln)]
+	return ok
+}
+
+func setKey(vuln *vulnerability.Vulnerability) string {
+	return strings.TrimSpace(vuln.VulnHash) + "/" + strings.TrimSpace(vuln.RuleID)
+}
+
+// Finder search suppression comments on files. The lines of the files are cached,
+// since the same file usually has many vulnerabilities from many tools.
+type Finder struct {
+	lines *file.Lines
+}
+
+// NewFinder create a new Finder to search suppressions on files.
+func NewFinder() *Finder {
+	return &Finder{
+		lines: file.NewLines(),
+	}
+}
+
+// Suppress set the vulnerability as false positive if its line or the preceding one
+// contains a suppression comment to its rule and return true if it was suppressed.
+// The vulnerability file is relative to projectPath, unless it's an absolute path.
+func (f *Finder) Suppress(projectPath string, vuln *vulnerability.Vulnerability) bool {
+	path := vuln.File
+	if path != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(projectPath, path)
+	}
+
+	suppression, found := f.Find(path, vuln.Line, vuln.RuleID)
+	if !found {
+		return false
+	}
+
+	vuln.Type = enumsVulnerability.FalsePositive
+	vuln.Details = fmt.Sprintf("%s\n%s", vuln.Details, suppression.Details())
+
+	return true
+}
+
+// Find return the suppression that applies to the rule on the line of file. The
+// line could be a single number or a range like "10-12", where only the first
+// line is considered.
+func (f *Finder) Find(path, line, ruleID string) (*Suppression, bool) {
+	lineNumber, err := strconv.Atoi(strings.TrimSpace(strings.Split(line, "-")[0]))
+	if err != nil || lineNumber <= 0 || path == "" {
+		return nil, false
+	}
+
+	lines := f.lines.Get(path)
+	if lineNumber > len(lines) {
+		return nil, false
+	}
+
+	if suppression, ok := Parse(lines[lineNumber-1]); ok && suppression.Matches(ruleID) {
+		return suppression, true
+	}
+
+	if lineNumber > 1 {
+		if suppression, ok := Parse(lines[lineNumber-2]); ok && suppression.standalone && suppression.Matches(ruleID) {
+			return suppression, true
+		}
+	}
+
+	return nil, false
+}
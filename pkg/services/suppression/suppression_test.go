@@ -0,0 +1,195 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suppression
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	enumsVulnerability "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testcases := []struct {
+		name       string
+		line       string
+		found      bool
+		ruleIDs    []string
+		reason     string
+		standalone bool
+	}{
+		{
+			name:       "slash comment with rule and reason",
+			line:       `// horusec:ignore HS-JAVA-123 reason="validated input"`,
+			found:      true,
+			ruleIDs:    []string{"HS-JAVA-123"},
+			reason:     "validated input",
+			standalone: true,
+		},
+		{
+			name:  "hash comment after code without rules",
+			line:  `password = "test" # horusec:ignore`,
+			found: true,
+		},
+		{
+			name:       "sql comment with many rules",
+			line:       `  -- horusec:ignore HS-SQL-1, HS-SQL-2`,
+			found:      true,
+			ruleIDs:    []string{"HS-SQL-1", "HS-SQL-2"},
+			standalone: true,
+		},
+		{
+			name:       "html comment",
+			line:       `<!-- horusec:ignore HS-HTML-1 reason="static page" -->`,
+			found:      true,
+			ruleIDs:    []string{"HS-HTML-1"},
+			reason:     "static page",
+			standalone: true,
+		},
+		{
+			name:  "no suppression",
+			line:  `// horusec is a security tool`,
+			found: false,
+		},
+		{
+			name:  "comment marker inside a string literal",
+			line:  `url := "http://example.com // horusec:ignore"`,
+			found: false,
+		},
+		{
+			name:  "hash inside a single quoted string",
+			line:  `color = '# horusec:ignore'`,
+			found: false,
+		},
+		{
+			name:  "comment marker not preceded by whitespace",
+			line:  `value = a#horusec:ignore`,
+			found: false,
+		},
+		{
+			name:    "trailing comment after a string with a comment marker",
+			line:    `url := "http://example.com # horusec:ignore" // horusec:ignore HS-GO-1`,
+			found:   true,
+			ruleIDs: []string{"HS-GO-1"},
+		},
+		{
+			name:  "trailing comment after a string with an escaped quote",
+			line:  `msg := "say \"hi\"" // horusec:ignore`,
+			found: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			suppression, found := Parse(tt.line)
+			require.Equal(t, tt.found, found)
+			if !found {
+				return
+			}
+
+			assert.Equal(t, tt.ruleIDs, suppression.RuleIDs)
+			assert.Equal(t, tt.reason, suppression.Reason)
+			assert.Equal(t, tt.standalone, suppression.standalone)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Run("should match all rules when no rule was informed", func(t *testing.T) {
+		assert.True(t, (&Suppression{}).Matches("HS-JAVA-1"))
+	})
+
+	t.Run("should match only informed rules", func(t *testing.T) {
+		suppression := &Suppression{RuleIDs: []string{"HS-JAVA-1", "G104"}}
+
+		assert.True(t, suppression.Matches("hs-java-1"))
+		assert.True(t, suppression.Matches("G104"))
+		assert.False(t, suppression.Matches("HS-JAVA-2"))
+	})
+}
+
+func TestFinderSuppress(t *testing.T) {
+	dir := t.TempDir()
+	src := "public class Test {\n" +
+		"    // horusec:ignore HS-JAVA-1 reason=\"false positive\"\n" +
+		"    String password = \"test\";\n" +
+		"    String token = \"test\"; // horusec:ignore\n" +
+		"    String key = \"test\";\n" +
+		"}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Test.java"), []byte(src), os.ModePerm))
+
+	finder := NewFinder()
+
+	t.Run("should suppress vulnerability with comment on preceding line", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{RuleID: "HS-JAVA-1", File: "Test.java", Line: "3", Details: "details"}
+
+		assert.True(t, finder.Suppress(dir, vuln))
+		assert.Equal(t, enumsVulnerability.FalsePositive, vuln.Type)
+		assert.Equal(t, "details\n"+DetailsPrefix+": false positive", vuln.Details)
+	})
+
+	t.Run("should suppress vulnerability with comment on same line", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{RuleID: "HS-JAVA-2", File: "Test.java", Line: "4"}
+
+		assert.True(t, finder.Suppress(dir, vuln))
+		assert.Equal(t, "\n"+DetailsPrefix, vuln.Details)
+	})
+
+	t.Run("should not suppress other rules", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{RuleID: "HS-JAVA-2", File: "Test.java", Line: "3"}
+
+		assert.False(t, finder.Suppress(dir, vuln))
+		assert.Empty(t, vuln.Type)
+	})
+
+	t.Run("should not suppress line after a trailing comment", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{RuleID: "HS-JAVA-2", File: "Test.java", Line: "5"}
+
+		assert.False(t, finder.Suppress(dir, vuln))
+	})
+
+	t.Run("should not suppress when line or file are invalid", func(t *testing.T) {
+		assert.False(t, finder.Suppress(dir, &vulnerability.Vulnerability{File: "Test.java", Line: "-"}))
+		assert.False(t, finder.Suppress(dir, &vulnerability.Vulnerability{File: "Test.java", Line: "100"}))
+		assert.False(t, finder.Suppress(dir, &vulnerability.Vulnerability{File: "Missing.java", Line: "1"}))
+	})
+}
+
+func TestSet(t *testing.T) {
+	set := Set{}
+	set.Add(&vulnerability.Vulnerability{RuleID: "HS-GO-6", VulnHash: "hash"})
+
+	t.Run("should contain vulnerability with same hash and rule", func(t *testing.T) {
+		assert.True(t, set.Contains(&vulnerability.Vulnerability{RuleID: "HS-GO-6", VulnHash: "hash", Details: "other"}))
+	})
+
+	t.Run("should not contain vulnerability of another rule with same hash", func(t *testing.T) {
+		assert.False(t, set.Contains(&vulnerability.Vulnerability{RuleID: "HS-LEAKS-26", VulnHash: "hash"}))
+	})
+
+	t.Run("should not contain vulnerability with suppression text on details", func(t *testing.T) {
+		assert.False(t, set.Contains(&vulnerability.Vulnerability{
+			RuleID: "HS-GO-7", VulnHash: "other", Type: enumsVulnerability.FalsePositive, Details: DetailsPrefix,
+		}))
+	})
+
+	t.Run("should not contain vulnerability on nil set", func(t *testing.T) {
+		assert.False(t, Set(nil).Contains(&vulnerability.Vulnerability{RuleID: "HS-GO-6", VulnHash: "hash"}))
+	})
+}
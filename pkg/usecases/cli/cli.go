@@ -36,6 +36,12 @@ import (
 	"github.com/mosajjal/horusec/pkg/services/git"
 )
 
+// outputTypes are all supported output types of an analysis.
+var outputTypes = []interface{}{
+	outputtype.JSON, outputtype.Sarif, outputtype.SonarQube, outputtype.HTML, outputtype.JUnit,
+	outputtype.GitLabSAST, outputtype.GitLabDependencyScanning, outputtype.CodeClimate, outputtype.Text,
+}
+
 // ValidateConfig validate if the fields from config has valid values.
 //
 // nolint
@@ -46,17 +52,24 @@ func ValidateConfig(cfg *config.Config) error {
 		validation.Field(&cfg.TimeoutInSecondsAnalysis, validation.Required, validation.Min(10)),
 		validation.Field(&cfg.MonitorRetryInSeconds, validation.Required, validation.Min(10)),
 		validation.Field(&cfg.RepositoryAuthorization, validation.Required, is.UUID),
-		validation.Field(&cfg.PrintOutputType, validation.In(outputtype.JSON, outputtype.Sarif, outputtype.SonarQube, outputtype.Text)),
+		validation.Field(&cfg.PrintOutputType, validation.In(outputTypes...)),
 		validation.Field(&cfg.JSONOutputFilePath, validation.By(validateJSONOutputFilePath(cfg))),
+		validation.Field(&cfg.Outputs, validation.By(validateOutputs(cfg))),
 		validation.Field(&cfg.SeveritiesToIgnore, validation.By(validationSeverities(cfg))),
 		validation.Field(&cfg.ReturnErrorIfFoundVulnerability, validation.In(true, false)),
 		validation.Field(&cfg.ProjectPath, validation.By(validateIfIsValidPath(cfg.ProjectPath))),
 		validation.Field(&cfg.WorkDir, validation.By(validateWorkDir(cfg.WorkDir, cfg.ProjectPath))),
 		validation.Field(&cfg.CertInsecureSkipVerify, validation.In(true, false)),
 		validation.Field(&cfg.CertPath, validation.By(validateCertPath(cfg.CertPath))),
+		validation.Field(&cfg.BaselineFilePath, validation.By(validateOptionalFilePath(cfg.BaselineFilePath))),
+		validation.Field(&cfg.PolicyFilePath, validation.By(validateOptionalFilePath(cfg.PolicyFilePath))),
 		validation.Field(&cfg.FalsePositiveHashes, validation.By(validateDuplicatedFalsePositiveHashes(cfg))),
 		validation.Field(&cfg.RiskAcceptHashes, validation.By(validateDuplicatedRiskAcceptHashes(cfg))),
 		validation.Field(&cfg.ShowVulnerabilitiesTypes, validation.By(validateVulnerabilitiesTypes(cfg))),
+		validation.Field(&cfg.FailOnSeverity, validation.By(validateFailOnSeverity(cfg.FailOnSeverity))),
+		validation.Field(&cfg.FailOnSeverityByType, validation.By(validateFailOnSeverityByType(cfg))),
+		validation.Field(&cfg.RiskAcceptances, validation.By(validateRiskAcceptances(cfg))),
+		validation.Field(&cfg.Rules, validation.By(validateRulesConfig(cfg))),
 		validation.Field(&cfg.EnableCommitAuthor, validation.By(validateGitDepthClone(cfg))),
 	)
 }
@@ -100,25 +113,67 @@ func validateDuplicatedRiskAcceptHashes(cfg *config.Config) validation.RuleFunc
 
 func validateJSONOutputFilePath(cfg *config.Config) validation.RuleFunc {
 	return func(value interface{}) error {
-		switch cfg.PrintOutputType {
-		case outputtype.JSON, outputtype.SonarQube:
-			return validateFilePathAndExtension(cfg, ".json")
-		case outputtype.Text:
-			return validateTextOutputFilePath(cfg)
+		return validateOutputFilePath(cfg.PrintOutputType, cfg.JSONOutputFilePath)
+	}
+}
+
+// validateOutputs validate the outputs in the format "type=path". All output types
+// except text are written only on file, so they require a path.
+func validateOutputs(cfg *config.Config) validation.RuleFunc {
+	return func(value interface{}) error {
+		for _, output := range cfg.Outputs {
+			outputType, path := outputtype.Parse(output)
+			if err := validation.Validate(outputType, validation.Required, validation.In(outputTypes...)); err != nil {
+				return fmt.Errorf("%s %s", messages.MsgErrorOutputTypeNotValid, output)
+			}
+
+			if outputType != outputtype.Text && path == "" {
+				return fmt.Errorf("%s %s", messages.MsgErrorJSONOutputFilePathNotValidUnknown, output)
+			}
+
+			if err := validateOutputFilePath(outputType, path); err != nil {
+				return err
+			}
 		}
 		return nil
 	}
 }
 
-func validateTextOutputFilePath(cfg *config.Config) error {
-	if cfg.JSONOutputFilePath == "" {
+func validateOutputFilePath(outputType, path string) error {
+	switch outputType {
+	case outputtype.JSON, outputtype.SonarQube, outputtype.GitLabSAST, outputtype.GitLabDependencyScanning,
+		outputtype.CodeClimate:
+		return validateFilePathAndExtension(path, ".json")
+	case outputtype.Sarif:
+		return validateRequiredFilePath(path)
+	case outputtype.HTML:
+		return validateFilePathAndExtension(path, ".html")
+	case outputtype.JUnit:
+		return validateFilePathAndExtension(path, ".xml")
+	case outputtype.Text:
+		return validateTextOutputFilePath(path)
+	}
+	return nil
+}
+
+func validateTextOutputFilePath(path string) error {
+	if path == "" {
 		return nil
 	}
-	return validateFilePathAndExtension(cfg, ".txt")
+	return validateFilePathAndExtension(path, ".txt")
 }
 
-func validateFilePathAndExtension(cfg *config.Config, extension string) error {
-	if filepath.Ext(cfg.JSONOutputFilePath) != extension {
+// validateRequiredFilePath validate the path of output types written on file whatever is its
+// extension, like sarif, which is commonly saved as ".sarif" or ".json".
+func validateRequiredFilePath(path string) error {
+	if path == "" {
+		return fmt.Errorf("%s %s", messages.MsgErrorJSONOutputFilePathNotValidUnknown, outputtype.Sarif)
+	}
+	return nil
+}
+
+func validateFilePathAndExtension(path, extension string) error {
+	if filepath.Ext(path) != extension {
 		return fmt.Errorf("%s %s", messages.MsgErrorJSONOutputFilePathNotValidExtension, extension)
 	}
 	return nil
@@ -176,6 +231,16 @@ func validateCertPath(dir string) validation.RuleFunc {
 	return validateIfIsValidPath(dir)
 }
 
+func validateOptionalFilePath(path string) validation.RuleFunc {
+	if path == "" {
+		return func(value interface{}) error {
+			return nil
+		}
+	}
+
+	return validateIfIsValidPath(path)
+}
+
 func validateWorkDir(workDir *workdir.WorkDir, projectPath string) validation.RuleFunc {
 	return func(value interface{}) error {
 		if workDir == nil {
@@ -216,6 +281,51 @@ func validateVulnerabilitiesTypes(cfg *config.Config) validation.RuleFunc {
 	}
 }
 
+func validateFailOnSeverity(failOnSeverity string) validation.RuleFunc {
+	return func(value interface{}) error {
+		failOnSeverity = strings.TrimSpace(failOnSeverity)
+		if failOnSeverity == "" || strings.EqualFold(failOnSeverity, config.FailOnSeverityNone) ||
+			checkIfExistItemInSliceOfSeverity(strings.ToUpper(failOnSeverity)) {
+			return nil
+		}
+
+		return fmt.Errorf("%s%s", messages.MsgErrorInvalidFailOnSeverity, failOnSeverity)
+	}
+}
+
+func validateFailOnSeverityByType(cfg *config.Config) validation.RuleFunc {
+	return func(value interface{}) error {
+		for vulnType, failOnSeverity := range cfg.FailOnSeverityByType {
+			if !isVulnerabilityValid(strings.TrimSpace(vulnType)) {
+				return fmt.Errorf("%s%s", messages.MsgErrorInvalidFailOnSeverityType, vulnType)
+			}
+
+			if err := validateFailOnSeverity(failOnSeverity)(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func validateRiskAcceptances(cfg *config.Config) validation.RuleFunc {
+	return func(value interface{}) error {
+		for index := range cfg.RiskAcceptances {
+			acceptance := &cfg.RiskAcceptances[index]
+			if _, err := acceptance.Expiration(); err != nil || strings.TrimSpace(acceptance.Hash) == "" {
+				return fmt.Errorf("%s%s", messages.MsgErrorInvalidRiskAcceptance, acceptance.Hash)
+			}
+		}
+		return nil
+	}
+}
+
+func validateRulesConfig(cfg *config.Config) validation.RuleFunc {
+	return func(value interface{}) error {
+		return cfg.Rules.Validate()
+	}
+}
+
 func isVulnerabilityValid(vulnType string) bool {
 	for _, valid := range vulnerability.Values() {
 		if strings.EqualFold(valid.ToString(), vulnType) {
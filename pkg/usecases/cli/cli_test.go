@@ -25,8 +25,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/mosajjal/horusec/config"
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
 	"github.com/mosajjal/horusec/pkg/entities/workdir"
 	"github.com/mosajjal/horusec/pkg/enums/outputtype"
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
 )
 
 func TestValidateConfigs(t *testing.T) {
@@ -64,6 +66,42 @@ func TestValidateConfigs(t *testing.T) {
 		expected := "severities_to_ignore: test Type of severity not valid. See severities enable: [CRITICAL HIGH MEDIUM LOW UNKNOWN INFO]."
 		assert.Equal(t, expected, err.Error())
 	})
+	t.Run("Should return no errors when valid fail on severity", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.FailOnSeverity = "high"
+		cfg.FailOnSeverityByType = map[string]string{"Risk Accepted": "NONE", "Vulnerability": "MEDIUM"}
+
+		err := ValidateConfig(cfg)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when invalid fail on severity", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.FailOnSeverity = "test"
+
+		err := ValidateConfig(cfg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), messages.MsgErrorInvalidFailOnSeverity+"test")
+	})
+	t.Run("Should return error when invalid vulnerability type on fail on severity by type", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.FailOnSeverityByType = map[string]string{"test": "HIGH"}
+
+		err := ValidateConfig(cfg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), messages.MsgErrorInvalidFailOnSeverityType+"test")
+	})
+	t.Run("Should return error when invalid risk acceptance", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.RiskAcceptances = riskacceptance.RiskAcceptances{{Hash: "hash1", ExpiresAt: "31/12/2026"}}
+
+		err := ValidateConfig(cfg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), messages.MsgErrorInvalidRiskAcceptance+"hash1")
+	})
 	t.Run("Should return error when invalid json output file is empty", func(t *testing.T) {
 		cfg := config.New()
 		cfg.WorkDir = &workdir.WorkDir{}
@@ -111,6 +149,114 @@ func TestValidateConfigs(t *testing.T) {
 		err = ValidateConfig(cfg)
 		assert.NoError(t, err)
 	})
+	t.Run("Should return error when the sarif output file is empty", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.Sarif
+		cfg.JSONOutputFilePath = ""
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "json_output_file_path: Output File path is required or is invalid: sarif.")
+	})
+	t.Run("Should not return error when the sarif output file is set", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.Sarif
+		cfg.JSONOutputFilePath = filepath.Join(t.TempDir(), "horusec.sarif")
+
+		err := ValidateConfig(cfg)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the html output file is invalid", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.HTML
+		cfg.JSONOutputFilePath = "test.json"
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "json_output_file_path: Output File path not valid file of type: .html.")
+	})
+	t.Run("Should not return error when the html output file is valid", func(t *testing.T) {
+		tmpPath, err := filepath.Abs("tmp")
+		assert.NoError(t, err)
+
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.HTML
+		cfg.JSONOutputFilePath = filepath.Join(tmpPath, uuid.NewString()+"-test.html")
+
+		err = ValidateConfig(cfg)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the junit output file is invalid", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.JUnit
+		cfg.JSONOutputFilePath = "test.json"
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "json_output_file_path: Output File path not valid file of type: .xml.")
+	})
+	t.Run("Should return error when the gitlab output file is invalid", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.GitLabSAST
+		cfg.JSONOutputFilePath = "gl-sast-report.txt"
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "json_output_file_path: Output File path not valid file of type: .json.")
+	})
+	t.Run("Should return error when the codeclimate output file is invalid", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.PrintOutputType = outputtype.CodeClimate
+		cfg.JSONOutputFilePath = "gl-code-quality-report.txt"
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "json_output_file_path: Output File path not valid file of type: .json.")
+	})
+	t.Run("Should not return error when the outputs are valid", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.Outputs = []string{"sarif=out.sarif", "json=out.json", "text"}
+
+		assert.NoError(t, ValidateConfig(cfg))
+	})
+	t.Run("Should return error when an output type is invalid", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.Outputs = []string{"json=out.json", "pdf=out.pdf"}
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "outputs: Output type not valid, it should be in the format type=path: pdf=out.pdf.")
+	})
+	t.Run("Should return error when an output file is missing", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.Outputs = []string{"sarif"}
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "outputs: Output File path is required or is invalid: sarif.")
+	})
+	t.Run("Should return error when an output file has invalid extension", func(t *testing.T) {
+		cfg := config.New()
+		cfg.WorkDir = &workdir.WorkDir{}
+		cfg.LoadFromEnvironmentVariables()
+		cfg.Outputs = []string{"html=report.json"}
+
+		err := ValidateConfig(cfg)
+		assert.EqualError(t, err, "outputs: Output File path not valid file of type: .html.")
+	})
 	t.Run("Should return error when invalid workdir", func(t *testing.T) {
 		cfg := &config.Config{}
 
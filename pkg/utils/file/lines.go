@@ -0,0 +1,84 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/mosajjal/horusec/pkg/helpers/messages"
+)
+
+// maxLineSize is the maximum size of a line read by Lines, since
+// minified files could have very long lines.
+const maxLineSize = 4 * 1024 * 1024
+
+// Lines is a cache of the lines of files, safe for concurrent use. It's useful
+// when the same file needs to be read many times, like when each vulnerability
+// of the file needs some lines of its source code.
+type Lines struct {
+	mutex *sync.Mutex
+	files map[string][]string
+}
+
+// NewLines create a new empty cache of file lines.
+func NewLines() *Lines {
+	return &Lines{
+		mutex: new(sync.Mutex),
+		files: make(map[string][]string),
+	}
+}
+
+// Get return the lines of the file on path. Files that could not be read
+// are cached without lines, so they are not read again.
+func (l *Lines) Get(path string) []string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	path = filepath.Clean(path)
+	if lines, exists := l.files[path]; exists {
+		return lines
+	}
+
+	lines, err := ReadLines(path)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugFileLinesNotRead, path, err)
+	}
+
+	l.files[path] = lines
+	return lines
+}
+
+// ReadLines read all lines of the file on path.
+func ReadLines(path string) ([]string, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines, scanner.Err()
+}
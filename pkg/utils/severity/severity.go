@@ -0,0 +1,37 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severity
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+)
+
+// rank return the position of severity on severities.Values, that are ordered
+// from the most to the least severe. Invalid severities have the lowest rank.
+func rank(severity severities.Severity) int {
+	for index, value := range severities.Values() {
+		if value == severity {
+			return index
+		}
+	}
+
+	return len(severities.Values())
+}
+
+// IsAtLeast return true if severity is equal or more severe than threshold,
+// e.g. HIGH and CRITICAL are at least HIGH, but MEDIUM is not.
+func IsAtLeast(severity, threshold severities.Severity) bool {
+	return rank(severity) <= rank(threshold)
+}
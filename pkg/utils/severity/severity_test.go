@@ -0,0 +1,36 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severity
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAtLeast(t *testing.T) {
+	t.Run("Should return true when severity is equal or more severe than threshold", func(t *testing.T) {
+		assert.True(t, IsAtLeast(severities.Critical, severities.High))
+		assert.True(t, IsAtLeast(severities.High, severities.High))
+		assert.True(t, IsAtLeast(severities.Low, severities.Info))
+	})
+
+	t.Run("Should return false when severity is less severe than threshold", func(t *testing.T) {
+		assert.False(t, IsAtLeast(severities.Medium, severities.High))
+		assert.False(t, IsAtLeast(severities.Info, severities.Low))
+		assert.False(t, IsAtLeast(severities.Severity("INVALID"), severities.Info))
+	})
+}
@@ -33,14 +33,19 @@ const (
 const (
 	StartFlagAnalysisTimeout            = "--analysis-timeout"
 	StartFlagAuthorization              = "--authorization"
+	StartFlagBaseline                   = "--baseline"
 	StartFlagCertificatePath            = "--certificate-path"
+	StartFlagChangedFiles               = "--changed-files"
 	StartFlagContainerBindProjectPath   = "--container-bind-project-path"
 	StartFlagCustomRulesPath            = "--custom-rules-path"
+	StartFlagEnableCache                = "--enable-cache"
 	StartFlagDisableDocker              = "--disable-docker"
 	StartFlagEnableCommitAuthor         = "--enable-commit-author"
 	StartFlagEnableGitHistory           = "--enable-git-history"
 	StartFlagEnableOwaspDependencyCheck = "--enable-owasp-dependency-check"
 	StartFlagEnableShellcheck           = "--enable-shellcheck"
+	StartFlagFailOnSeverity             = "--fail-on-severity"
+	StartFlagFailOnSeverityByType       = "--fail-on-severity-by-type"
 	StartFlagFalsePositive              = "--false-positive"
 	StartFlagHeaders                    = "--headers"
 	StartFlagHorusecURL                 = "--horusec-url"
@@ -50,25 +55,31 @@ const (
 	StartFlagInsecureSkipVerify         = "--insecure-skip-verify"
 	StartFlagJSONOutputFilePath         = "--json-output-file"
 	StartFlagMonitorRetryCount          = "--monitor-retry-count"
+	StartFlagOutput                     = "--output"
 	StartFlagOutputFormat               = "--output-format"
+	StartFlagPolicy                     = "--policy"
 	StartFlagProjectPath                = "--project-path"
 	StartFlagRepositoryName             = "--repository-name"
 	StartFlagRequestTimeout             = "--request-timeout"
 	StartFlagReturnError                = "--return-error"
 	StartFlagRiskAccept                 = "--risk-accept"
+	StartFlagRiskAcceptanceWarningDays  = "--risk-acceptance-warning-days"
 	StartFlagShowVulnerabilitiesTypes   = "--show-vulnerabilities-types"
+	StartFlagSince                      = "--since"
 )
 
 func GetAllStartFlags() []string {
 	return []string{
-		StartFlagAnalysisTimeout, StartFlagAuthorization, StartFlagCertificatePath,
-		StartFlagContainerBindProjectPath, StartFlagCustomRulesPath, StartFlagDisableDocker,
+		StartFlagAnalysisTimeout, StartFlagAuthorization, StartFlagBaseline, StartFlagCertificatePath,
+		StartFlagChangedFiles,
+		StartFlagContainerBindProjectPath, StartFlagCustomRulesPath, StartFlagEnableCache, StartFlagDisableDocker,
 		StartFlagEnableCommitAuthor, StartFlagEnableGitHistory, StartFlagEnableOwaspDependencyCheck,
-		StartFlagEnableShellcheck, StartFlagFalsePositive, StartFlagHeaders,
+		StartFlagEnableShellcheck, StartFlagFailOnSeverity, StartFlagFailOnSeverityByType, StartFlagFalsePositive,
+		StartFlagHeaders,
 		StartFlagHorusecURL, StartFlagIgnore, StartFlagIgnoreSeverity,
 		StartFlagInformationSeverity, StartFlagInsecureSkipVerify, StartFlagJSONOutputFilePath,
-		StartFlagMonitorRetryCount, StartFlagOutputFormat, StartFlagProjectPath,
+		StartFlagMonitorRetryCount, StartFlagOutput, StartFlagOutputFormat, StartFlagPolicy, StartFlagProjectPath,
 		StartFlagRepositoryName, StartFlagRequestTimeout, StartFlagReturnError,
-		StartFlagRiskAccept, StartFlagShowVulnerabilitiesTypes,
+		StartFlagRiskAccept, StartFlagRiskAcceptanceWarningDays, StartFlagShowVulnerabilitiesTypes, StartFlagSince,
 	}
 }
@@ -128,3 +128,13 @@ func (m *FormatterMock) IsShellcheckDisable() bool {
 	args := m.MethodCalled("IsShellcheckDisable")
 	return args.Get(0).(bool)
 }
+
+func (m *FormatterMock) IsCacheDisabled() bool {
+	args := m.MethodCalled("IsCacheDisabled")
+	return args.Get(0).(bool)
+}
+
+func (m *FormatterMock) IsRuleDisabled(_ string) bool {
+	args := m.MethodCalled("IsRuleDisabled")
+	return args.Get(0).(bool)
+}
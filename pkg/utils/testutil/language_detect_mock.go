@@ -18,6 +18,8 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
 	mockutils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 	"github.com/stretchr/testify/mock"
+
+	"github.com/mosajjal/horusec/pkg/services/git"
 )
 
 type LanguageDetectMock struct {
@@ -32,3 +34,7 @@ func (m *LanguageDetectMock) Detect(_ string) ([]languages.Language, error) {
 	args := m.MethodCalled("LanguageDetect")
 	return args.Get(0).([]languages.Language), mockutils.ReturnNilOrError(args, 1)
 }
+
+func (m *LanguageDetectMock) SetChanges(_ git.Changes) {
+	_ = m.MethodCalled("SetChanges")
+}
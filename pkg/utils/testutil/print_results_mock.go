@@ -18,6 +18,8 @@ import (
 	entitiesAnalysis "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	mockutils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 	"github.com/stretchr/testify/mock"
+
+	"github.com/mosajjal/horusec/pkg/entities/riskacceptance"
 )
 
 type PrintResultsMock struct {
@@ -36,3 +38,11 @@ func (m *PrintResultsMock) Print() (totalVulns int, err error) {
 func (m *PrintResultsMock) SetAnalysis(analysis *entitiesAnalysis.Analysis) {
 	_ = m.MethodCalled("SetAnalysis")
 }
+
+func (m *PrintResultsMock) SetSuppressedVulnerabilities(_ []entitiesAnalysis.AnalysisVulnerabilities) {
+	_ = m.MethodCalled("SetSuppressedVulnerabilities")
+}
+
+func (m *PrintResultsMock) SetRiskAcceptances(_ riskacceptance.RiskAcceptances) {
+	_ = m.MethodCalled("SetRiskAcceptances")
+}
@@ -16,11 +16,15 @@ package vulnhash
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+
+	"github.com/mosajjal/horusec/pkg/utils/file"
 )
 
 // Bind create a sha256 hash of the vulnerability using the vulnerability code, line and file. The file path should
@@ -61,6 +65,74 @@ func Bind(vuln *vulnerability.Vulnerability) *vulnerability.Vulnerability {
 	return vuln
 }
 
+// FingerprintContextLines is the number of lines before and after the vulnerability line that are
+// used as context window to generate the fingerprint.
+const FingerprintContextLines = 2
+
+// Fingerprint create a sha256 hash of the vulnerability using the rule id, file, code and the lines around
+// the vulnerability on source, that are the lines of the vulnerability file. Unlike the Vulnerability.VulnHash,
+// the line number is not used, so the fingerprint remains the same when the code of the vulnerability is moved
+// to another line of the same file. If source is empty, only the rule id, file and code are used.
+func Fingerprint(vuln *vulnerability.Vulnerability, source []string) string {
+	return crypto.GenerateSHA256(
+		vuln.RuleID,
+		vuln.File,
+		toOneLine(vuln.Code),
+		contextWindow(vuln.Line, source),
+	)
+}
+
+// contextWindow return the lines around line of source without any white space, so changes
+// of indentation or line endings don't change the fingerprint.
+func contextWindow(line string, source []string) string {
+	lineNumber, err := strconv.Atoi(strings.TrimSpace(strings.Split(line, "-")[0]))
+	if err != nil || lineNumber <= 0 || lineNumber > len(source) {
+		return ""
+	}
+
+	start := lineNumber - 1 - FingerprintContextLines
+	if start < 0 {
+		start = 0
+	}
+
+	end := lineNumber + FingerprintContextLines
+	if end > len(source) {
+		end = len(source)
+	}
+
+	return strings.Join(strings.Fields(strings.Join(source[start:end], "\n")), "")
+}
+
+// Fingerprinter generate the fingerprints of the vulnerabilities of a project, reading the
+// context window from the vulnerability file relative to the project path.
+type Fingerprinter struct {
+	projectPath string
+	lines       *file.Lines
+}
+
+// NewFingerprinter create a new Fingerprinter to vulnerabilities of projectPath.
+func NewFingerprinter(projectPath string) *Fingerprinter {
+	return &Fingerprinter{
+		projectPath: projectPath,
+		lines:       file.NewLines(),
+	}
+}
+
+// Fingerprint return the fingerprint of the vulnerability. See Fingerprint for more info.
+// A nil Fingerprinter generate the fingerprints without the context window.
+func (f *Fingerprinter) Fingerprint(vuln *vulnerability.Vulnerability) string {
+	if f == nil || vuln.File == "" {
+		return Fingerprint(vuln, nil)
+	}
+
+	path := vuln.File
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(f.projectPath, path)
+	}
+
+	return Fingerprint(vuln, f.lines.Get(path))
+}
+
 func toOneLine(code string) string {
 	re := regexp.MustCompile(`\r?\n?\t`)
 	// remove line break
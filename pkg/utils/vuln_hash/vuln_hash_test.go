@@ -15,10 +15,14 @@
 package vulnhash
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBind(t *testing.T) {
@@ -37,6 +41,77 @@ func TestBind(t *testing.T) {
 	assert.Equal(t, "278facfff87828631a37b27d76d1a926bed37466b05cab7d365d7f5c7345ac6d", vuln.DeprecatedHashes[1])
 }
 
+func TestFingerprint(t *testing.T) {
+	source := []string{
+		"package main",
+		"",
+		"func main() {",
+		`	fmt.Println("testing")`,
+		"}",
+	}
+	vuln := vulnerability.Vulnerability{
+		RuleID: "HS-GO-1",
+		Code:   `fmt.Println("testing")`,
+		Line:   "4",
+		File:   "main.go",
+	}
+
+	fingerprint := Fingerprint(&vuln, source)
+
+	t.Run("should keep fingerprint when lines are added above the vulnerability", func(t *testing.T) {
+		shifted := vuln
+		shifted.Line = "6"
+
+		assert.Equal(t, fingerprint, Fingerprint(&shifted, append([]string{"// comment", ""}, source...)))
+	})
+
+	t.Run("should keep fingerprint when indentation changes", func(t *testing.T) {
+		indented := append([]string{}, source...)
+		indented[3] = `        fmt.Println("testing")`
+
+		assert.Equal(t, fingerprint, Fingerprint(&vuln, indented))
+	})
+
+	t.Run("should change fingerprint when context changes", func(t *testing.T) {
+		changed := append([]string{}, source...)
+		changed[2] = "func init() {"
+
+		assert.NotEqual(t, fingerprint, Fingerprint(&vuln, changed))
+	})
+
+	t.Run("should change fingerprint when file changes", func(t *testing.T) {
+		other := vuln
+		other.File = "other.go"
+
+		assert.NotEqual(t, fingerprint, Fingerprint(&other, source))
+	})
+
+	t.Run("should ignore context when line is out of source", func(t *testing.T) {
+		outOfSource := vuln
+		outOfSource.Line = "100"
+
+		assert.Equal(t, Fingerprint(&vuln, nil), Fingerprint(&outOfSource, source))
+	})
+}
+
+func TestFingerprinter(t *testing.T) {
+	dir := t.TempDir()
+	source := "package main\n\nfunc main() {\n\tfmt.Println(\"testing\")\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(source), os.ModePerm))
+
+	vuln := vulnerability.Vulnerability{
+		RuleID: "HS-GO-1",
+		Code:   `fmt.Println("testing")`,
+		Line:   "4",
+		File:   "main.go",
+	}
+
+	fingerprint := NewFingerprinter(dir).Fingerprint(&vuln)
+
+	assert.Equal(t, Fingerprint(&vuln, strings.Split(strings.TrimSuffix(source, "\n"), "\n")), fingerprint)
+	assert.NotEqual(t, Fingerprint(&vuln, nil), fingerprint)
+}
+
 func TestToOneLine(t *testing.T) {
 	t.Run("should compress an string to a one line string wihtout whitespaces", func(t *testing.T) {
 		str := "func() {" +
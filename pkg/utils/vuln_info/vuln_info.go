@@ -0,0 +1,76 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulninfo
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+)
+
+// RuleName return the rule id of the vulnerability. Since not all tools set the rule id,
+// the security tool is used as fallback.
+func RuleName(vuln *vulnerability.Vulnerability) string {
+	if vuln.RuleID != "" {
+		return vuln.RuleID
+	}
+
+	return vuln.SecurityTool.ToString()
+}
+
+// Title return the first line of the vulnerability details with at most maxLength bytes, for
+// output formats that show a single line or limit its length. The rule name is used when the
+// vulnerability has no details.
+func Title(vuln *vulnerability.Vulnerability, maxLength int) string {
+	title := strings.TrimSpace(strings.SplitN(vuln.Details, "\n", 2)[0])
+	if title == "" {
+		title = RuleName(vuln)
+	}
+
+	if len(title) <= maxLength {
+		return title
+	}
+
+	// Avoid cutting a multi-byte character in half.
+	title = title[:maxLength]
+	for len(title) > 0 && !utf8.ValidString(title) {
+		title = title[:len(title)-1]
+	}
+
+	return title
+}
+
+// LineRange return the first and last lines of a vulnerability line, that can be a single line,
+// e.g. "10", or a range of lines, e.g. "10-12". Zero is returned for both when line is invalid.
+func LineRange(line string) (start, end int) {
+	lines := strings.SplitN(line, "-", 2)
+
+	start, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil || start <= 0 {
+		return 0, 0
+	}
+
+	if len(lines) == 1 {
+		return start, start
+	}
+
+	if end, err = strconv.Atoi(strings.TrimSpace(lines[1])); err != nil || end < start {
+		return start, start
+	}
+
+	return start, end
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulninfo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleName(t *testing.T) {
+	t.Run("Should return rule id of vulnerability", func(t *testing.T) {
+		assert.Equal(t, "HS-GO-1", RuleName(&vulnerability.Vulnerability{RuleID: "HS-GO-1", SecurityTool: tools.GoSec}))
+	})
+
+	t.Run("Should return security tool when vulnerability has no rule id", func(t *testing.T) {
+		assert.Equal(t, "GoSec", RuleName(&vulnerability.Vulnerability{SecurityTool: tools.GoSec}))
+	})
+}
+
+func TestTitle(t *testing.T) {
+	t.Run("Should return first line of details", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{Details: "  Hardcoded password  \nMore details"}
+		assert.Equal(t, "Hardcoded password", Title(vuln, 255))
+	})
+
+	t.Run("Should truncate first line of details to max length", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{Details: strings.Repeat("a", 300)}
+		assert.Equal(t, strings.Repeat("a", 255), Title(vuln, 255))
+	})
+
+	t.Run("Should not cut multi-byte characters when truncating", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{Details: "aé"}
+		assert.Equal(t, "a", Title(vuln, 2))
+	})
+
+	t.Run("Should return rule name when vulnerability has no details", func(t *testing.T) {
+		vuln := &vulnerability.Vulnerability{RuleID: "HS-GO-1"}
+		assert.Equal(t, "HS-GO-1", Title(vuln, 255))
+	})
+}
+
+func TestLineRange(t *testing.T) {
+	testcases := []struct {
+		line  string
+		start int
+		end   int
+	}{
+		{line: "10", start: 10, end: 10},
+		{line: "10-12", start: 10, end: 12},
+		{line: " 10 - 12 ", start: 10, end: 12},
+		{line: "10-", start: 10, end: 10},
+		{line: "12-10", start: 12, end: 12},
+		{line: "", start: 0, end: 0},
+		{line: "-", start: 0, end: 0},
+		{line: "0", start: 0, end: 0},
+		{line: "invalid", start: 0, end: 0},
+	}
+
+	for _, tt := range testcases {
+		start, end := LineRange(tt.line)
+		assert.Equal(t, tt.start, start, tt.line)
+		assert.Equal(t, tt.end, end, tt.line)
+	}
+}